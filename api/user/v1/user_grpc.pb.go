@@ -19,19 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	User_OpenCardHandle_FullMethodName    = "/api.user.v1.User/OpenCardHandle"
-	User_CardStatusHandle_FullMethodName  = "/api.user.v1.User/CardStatusHandle"
-	User_Deposit_FullMethodName           = "/api.user.v1.User/Deposit"
-	User_AdminWithdrawEth_FullMethodName  = "/api.user.v1.User/AdminWithdrawEth"
-	User_RewardCardTwo_FullMethodName     = "/api.user.v1.User/RewardCardTwo"
-	User_AdminRewardList_FullMethodName   = "/api.user.v1.User/AdminRewardList"
-	User_AdminUserList_FullMethodName     = "/api.user.v1.User/AdminUserList"
-	User_AdminLogin_FullMethodName        = "/api.user.v1.User/AdminLogin"
-	User_UpdateCanVip_FullMethodName      = "/api.user.v1.User/UpdateCanVip"
-	User_SetVipThree_FullMethodName       = "/api.user.v1.User/SetVipThree"
-	User_SetUserCount_FullMethodName      = "/api.user.v1.User/SetUserCount"
-	User_AdminConfig_FullMethodName       = "/api.user.v1.User/AdminConfig"
-	User_AdminConfigUpdate_FullMethodName = "/api.user.v1.User/AdminConfigUpdate"
+	User_OpenCardHandle_FullMethodName              = "/api.user.v1.User/OpenCardHandle"
+	User_CardStatusHandle_FullMethodName            = "/api.user.v1.User/CardStatusHandle"
+	User_Deposit_FullMethodName                     = "/api.user.v1.User/Deposit"
+	User_AdminWithdrawEth_FullMethodName            = "/api.user.v1.User/AdminWithdrawEth"
+	User_RewardCardTwo_FullMethodName               = "/api.user.v1.User/RewardCardTwo"
+	User_CallbackEventHandle_FullMethodName         = "/api.user.v1.User/CallbackEventHandle"
+	User_AdminCallbackEventList_FullMethodName      = "/api.user.v1.User/AdminCallbackEventList"
+	User_CardProductSyncHandle_FullMethodName       = "/api.user.v1.User/CardProductSyncHandle"
+	User_AdminCardProductList_FullMethodName        = "/api.user.v1.User/AdminCardProductList"
+	User_AdminCardProductEnable_FullMethodName      = "/api.user.v1.User/AdminCardProductEnable"
+	User_AdminCardProductUserCardMax_FullMethodName = "/api.user.v1.User/AdminCardProductUserCardMax"
+	User_AdminCardProductDefault_FullMethodName     = "/api.user.v1.User/AdminCardProductDefault"
+	User_CardRechargeHandle_FullMethodName          = "/api.user.v1.User/CardRechargeHandle"
+	User_AdminCardRecharge_FullMethodName           = "/api.user.v1.User/AdminCardRecharge"
+	User_AdminCardRechargeList_FullMethodName       = "/api.user.v1.User/AdminCardRechargeList"
+	User_AdminCardFreeze_FullMethodName             = "/api.user.v1.User/AdminCardFreeze"
+	User_AdminCardCancel_FullMethodName             = "/api.user.v1.User/AdminCardCancel"
+	User_AdminCardLimit_FullMethodName              = "/api.user.v1.User/AdminCardLimit"
+	User_CardTransactionSyncHandle_FullMethodName   = "/api.user.v1.User/CardTransactionSyncHandle"
+	User_AdminCardTransactionList_FullMethodName    = "/api.user.v1.User/AdminCardTransactionList"
+	User_CardBalanceHandle_FullMethodName           = "/api.user.v1.User/CardBalanceHandle"
+	User_AdminCardBalanceDriftList_FullMethodName   = "/api.user.v1.User/AdminCardBalanceDriftList"
+	User_AdminCardTwoOrderList_FullMethodName       = "/api.user.v1.User/AdminCardTwoOrderList"
+	User_AdminCardTwoOrderStatus_FullMethodName     = "/api.user.v1.User/AdminCardTwoOrderStatus"
+	User_AdminCardTwoOrderAddress_FullMethodName    = "/api.user.v1.User/AdminCardTwoOrderAddress"
+	User_AdminUserCardList_FullMethodName           = "/api.user.v1.User/AdminUserCardList"
+	User_AdminUserCardApply_FullMethodName          = "/api.user.v1.User/AdminUserCardApply"
+	User_AdminUserCardHistoryList_FullMethodName    = "/api.user.v1.User/AdminUserCardHistoryList"
+	User_SensitiveEncryptHandle_FullMethodName      = "/api.user.v1.User/SensitiveEncryptHandle"
+	User_WithdrawConfirmHandle_FullMethodName       = "/api.user.v1.User/WithdrawConfirmHandle"
+	User_AdminWithdrawList_FullMethodName           = "/api.user.v1.User/AdminWithdrawList"
+	User_AdminWithdrawAudit_FullMethodName          = "/api.user.v1.User/AdminWithdrawAudit"
+	User_AdminWithdrawReplace_FullMethodName        = "/api.user.v1.User/AdminWithdrawReplace"
+	User_AdminCardReveal_FullMethodName             = "/api.user.v1.User/AdminCardReveal"
+	User_AdminCardRevealLogList_FullMethodName      = "/api.user.v1.User/AdminCardRevealLogList"
+	User_AdminIssuerJournalList_FullMethodName      = "/api.user.v1.User/AdminIssuerJournalList"
+	User_AdminRewardList_FullMethodName             = "/api.user.v1.User/AdminRewardList"
+	User_AdminUserList_FullMethodName               = "/api.user.v1.User/AdminUserList"
+	User_AdminLogin_FullMethodName                  = "/api.user.v1.User/AdminLogin"
+	User_UpdateCanVip_FullMethodName                = "/api.user.v1.User/UpdateCanVip"
+	User_SetVipThree_FullMethodName                 = "/api.user.v1.User/SetVipThree"
+	User_SetUserCount_FullMethodName                = "/api.user.v1.User/SetUserCount"
+	User_AdminConfig_FullMethodName                 = "/api.user.v1.User/AdminConfig"
+	User_AdminConfigUpdate_FullMethodName           = "/api.user.v1.User/AdminConfigUpdate"
 )
 
 // UserClient is the client API for User service.
@@ -44,6 +75,49 @@ type UserClient interface {
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest, opts ...grpc.CallOption) (*RewardCardTwoReply, error)
+	// 回调处理
+	CallbackEventHandle(ctx context.Context, in *CallbackEventHandleRequest, opts ...grpc.CallOption) (*CallbackEventHandleReply, error)
+	AdminCallbackEventList(ctx context.Context, in *AdminCallbackEventListRequest, opts ...grpc.CallOption) (*AdminCallbackEventListReply, error)
+	// 卡产品同步
+	CardProductSyncHandle(ctx context.Context, in *CardProductSyncHandleRequest, opts ...grpc.CallOption) (*CardProductSyncHandleReply, error)
+	AdminCardProductList(ctx context.Context, in *AdminCardProductListRequest, opts ...grpc.CallOption) (*AdminCardProductListReply, error)
+	AdminCardProductEnable(ctx context.Context, in *AdminCardProductEnableRequest, opts ...grpc.CallOption) (*AdminCardProductEnableReply, error)
+	AdminCardProductUserCardMax(ctx context.Context, in *AdminCardProductUserCardMaxRequest, opts ...grpc.CallOption) (*AdminCardProductUserCardMaxReply, error)
+	AdminCardProductDefault(ctx context.Context, in *AdminCardProductDefaultRequest, opts ...grpc.CallOption) (*AdminCardProductDefaultReply, error)
+	// 虚拟卡充值
+	CardRechargeHandle(ctx context.Context, in *CardRechargeHandleRequest, opts ...grpc.CallOption) (*CardRechargeHandleReply, error)
+	AdminCardRecharge(ctx context.Context, in *AdminCardRechargeRequest, opts ...grpc.CallOption) (*AdminCardRechargeReply, error)
+	AdminCardRechargeList(ctx context.Context, in *AdminCardRechargeListRequest, opts ...grpc.CallOption) (*AdminCardRechargeListReply, error)
+	// 卡片操作
+	AdminCardFreeze(ctx context.Context, in *AdminCardFreezeRequest, opts ...grpc.CallOption) (*AdminCardFreezeReply, error)
+	AdminCardCancel(ctx context.Context, in *AdminCardCancelRequest, opts ...grpc.CallOption) (*AdminCardCancelReply, error)
+	AdminCardLimit(ctx context.Context, in *AdminCardLimitRequest, opts ...grpc.CallOption) (*AdminCardLimitReply, error)
+	CardTransactionSyncHandle(ctx context.Context, in *CardTransactionSyncHandleRequest, opts ...grpc.CallOption) (*CardTransactionSyncHandleReply, error)
+	AdminCardTransactionList(ctx context.Context, in *AdminCardTransactionListRequest, opts ...grpc.CallOption) (*AdminCardTransactionListReply, error)
+	CardBalanceHandle(ctx context.Context, in *CardBalanceHandleRequest, opts ...grpc.CallOption) (*CardBalanceHandleReply, error)
+	AdminCardBalanceDriftList(ctx context.Context, in *AdminCardBalanceDriftListRequest, opts ...grpc.CallOption) (*AdminCardBalanceDriftListReply, error)
+	// 实体卡订单
+	AdminCardTwoOrderList(ctx context.Context, in *AdminCardTwoOrderListRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderListReply, error)
+	AdminCardTwoOrderStatus(ctx context.Context, in *AdminCardTwoOrderStatusRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderStatusReply, error)
+	AdminCardTwoOrderAddress(ctx context.Context, in *AdminCardTwoOrderAddressRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderAddressReply, error)
+	AdminUserCardList(ctx context.Context, in *AdminUserCardListRequest, opts ...grpc.CallOption) (*AdminUserCardListReply, error)
+	AdminUserCardApply(ctx context.Context, in *AdminUserCardApplyRequest, opts ...grpc.CallOption) (*AdminUserCardApplyReply, error)
+	AdminUserCardHistoryList(ctx context.Context, in *AdminUserCardHistoryListRequest, opts ...grpc.CallOption) (*AdminUserCardHistoryListReply, error)
+	// 存量卡号和KYC字段加密
+	SensitiveEncryptHandle(ctx context.Context, in *SensitiveEncryptHandleRequest, opts ...grpc.CallOption) (*SensitiveEncryptHandleReply, error)
+	// 提现交易确认
+	WithdrawConfirmHandle(ctx context.Context, in *WithdrawConfirmHandleRequest, opts ...grpc.CallOption) (*WithdrawConfirmHandleReply, error)
+	// 提现列表
+	AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error)
+	// 提现人工审核
+	AdminWithdrawAudit(ctx context.Context, in *AdminWithdrawAuditRequest, opts ...grpc.CallOption) (*AdminWithdrawAuditReply, error)
+	// 卡住的提现交易加速或取消
+	AdminWithdrawReplace(ctx context.Context, in *AdminWithdrawReplaceRequest, opts ...grpc.CallOption) (*AdminWithdrawReplaceReply, error)
+	// 查看完整卡号，需要超级管理员重新输入密码，每次请求都记录
+	AdminCardReveal(ctx context.Context, in *AdminCardRevealRequest, opts ...grpc.CallOption) (*AdminCardRevealReply, error)
+	AdminCardRevealLogList(ctx context.Context, in *AdminCardRevealLogListRequest, opts ...grpc.CallOption) (*AdminCardRevealLogListReply, error)
+	// 发卡方请求日志
+	AdminIssuerJournalList(ctx context.Context, in *AdminIssuerJournalListRequest, opts ...grpc.CallOption) (*AdminIssuerJournalListReply, error)
 	AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error)
 	AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...grpc.CallOption) (*AdminUserListReply, error)
 	AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...grpc.CallOption) (*AdminLoginReply, error)
@@ -107,6 +181,285 @@ func (c *userClient) RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest
 	return out, nil
 }
 
+func (c *userClient) CallbackEventHandle(ctx context.Context, in *CallbackEventHandleRequest, opts ...grpc.CallOption) (*CallbackEventHandleReply, error) {
+	out := new(CallbackEventHandleReply)
+	err := c.cc.Invoke(ctx, User_CallbackEventHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCallbackEventList(ctx context.Context, in *AdminCallbackEventListRequest, opts ...grpc.CallOption) (*AdminCallbackEventListReply, error) {
+	out := new(AdminCallbackEventListReply)
+	err := c.cc.Invoke(ctx, User_AdminCallbackEventList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CardProductSyncHandle(ctx context.Context, in *CardProductSyncHandleRequest, opts ...grpc.CallOption) (*CardProductSyncHandleReply, error) {
+	out := new(CardProductSyncHandleReply)
+	err := c.cc.Invoke(ctx, User_CardProductSyncHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardProductList(ctx context.Context, in *AdminCardProductListRequest, opts ...grpc.CallOption) (*AdminCardProductListReply, error) {
+	out := new(AdminCardProductListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardProductList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardProductEnable(ctx context.Context, in *AdminCardProductEnableRequest, opts ...grpc.CallOption) (*AdminCardProductEnableReply, error) {
+	out := new(AdminCardProductEnableReply)
+	err := c.cc.Invoke(ctx, User_AdminCardProductEnable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardProductUserCardMax(ctx context.Context, in *AdminCardProductUserCardMaxRequest, opts ...grpc.CallOption) (*AdminCardProductUserCardMaxReply, error) {
+	out := new(AdminCardProductUserCardMaxReply)
+	err := c.cc.Invoke(ctx, User_AdminCardProductUserCardMax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardProductDefault(ctx context.Context, in *AdminCardProductDefaultRequest, opts ...grpc.CallOption) (*AdminCardProductDefaultReply, error) {
+	out := new(AdminCardProductDefaultReply)
+	err := c.cc.Invoke(ctx, User_AdminCardProductDefault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CardRechargeHandle(ctx context.Context, in *CardRechargeHandleRequest, opts ...grpc.CallOption) (*CardRechargeHandleReply, error) {
+	out := new(CardRechargeHandleReply)
+	err := c.cc.Invoke(ctx, User_CardRechargeHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardRecharge(ctx context.Context, in *AdminCardRechargeRequest, opts ...grpc.CallOption) (*AdminCardRechargeReply, error) {
+	out := new(AdminCardRechargeReply)
+	err := c.cc.Invoke(ctx, User_AdminCardRecharge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardRechargeList(ctx context.Context, in *AdminCardRechargeListRequest, opts ...grpc.CallOption) (*AdminCardRechargeListReply, error) {
+	out := new(AdminCardRechargeListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardRechargeList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardFreeze(ctx context.Context, in *AdminCardFreezeRequest, opts ...grpc.CallOption) (*AdminCardFreezeReply, error) {
+	out := new(AdminCardFreezeReply)
+	err := c.cc.Invoke(ctx, User_AdminCardFreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardCancel(ctx context.Context, in *AdminCardCancelRequest, opts ...grpc.CallOption) (*AdminCardCancelReply, error) {
+	out := new(AdminCardCancelReply)
+	err := c.cc.Invoke(ctx, User_AdminCardCancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardLimit(ctx context.Context, in *AdminCardLimitRequest, opts ...grpc.CallOption) (*AdminCardLimitReply, error) {
+	out := new(AdminCardLimitReply)
+	err := c.cc.Invoke(ctx, User_AdminCardLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CardTransactionSyncHandle(ctx context.Context, in *CardTransactionSyncHandleRequest, opts ...grpc.CallOption) (*CardTransactionSyncHandleReply, error) {
+	out := new(CardTransactionSyncHandleReply)
+	err := c.cc.Invoke(ctx, User_CardTransactionSyncHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardTransactionList(ctx context.Context, in *AdminCardTransactionListRequest, opts ...grpc.CallOption) (*AdminCardTransactionListReply, error) {
+	out := new(AdminCardTransactionListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardTransactionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CardBalanceHandle(ctx context.Context, in *CardBalanceHandleRequest, opts ...grpc.CallOption) (*CardBalanceHandleReply, error) {
+	out := new(CardBalanceHandleReply)
+	err := c.cc.Invoke(ctx, User_CardBalanceHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardBalanceDriftList(ctx context.Context, in *AdminCardBalanceDriftListRequest, opts ...grpc.CallOption) (*AdminCardBalanceDriftListReply, error) {
+	out := new(AdminCardBalanceDriftListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardBalanceDriftList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardTwoOrderList(ctx context.Context, in *AdminCardTwoOrderListRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderListReply, error) {
+	out := new(AdminCardTwoOrderListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardTwoOrderList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardTwoOrderStatus(ctx context.Context, in *AdminCardTwoOrderStatusRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderStatusReply, error) {
+	out := new(AdminCardTwoOrderStatusReply)
+	err := c.cc.Invoke(ctx, User_AdminCardTwoOrderStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardTwoOrderAddress(ctx context.Context, in *AdminCardTwoOrderAddressRequest, opts ...grpc.CallOption) (*AdminCardTwoOrderAddressReply, error) {
+	out := new(AdminCardTwoOrderAddressReply)
+	err := c.cc.Invoke(ctx, User_AdminCardTwoOrderAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminUserCardList(ctx context.Context, in *AdminUserCardListRequest, opts ...grpc.CallOption) (*AdminUserCardListReply, error) {
+	out := new(AdminUserCardListReply)
+	err := c.cc.Invoke(ctx, User_AdminUserCardList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminUserCardApply(ctx context.Context, in *AdminUserCardApplyRequest, opts ...grpc.CallOption) (*AdminUserCardApplyReply, error) {
+	out := new(AdminUserCardApplyReply)
+	err := c.cc.Invoke(ctx, User_AdminUserCardApply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminUserCardHistoryList(ctx context.Context, in *AdminUserCardHistoryListRequest, opts ...grpc.CallOption) (*AdminUserCardHistoryListReply, error) {
+	out := new(AdminUserCardHistoryListReply)
+	err := c.cc.Invoke(ctx, User_AdminUserCardHistoryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SensitiveEncryptHandle(ctx context.Context, in *SensitiveEncryptHandleRequest, opts ...grpc.CallOption) (*SensitiveEncryptHandleReply, error) {
+	out := new(SensitiveEncryptHandleReply)
+	err := c.cc.Invoke(ctx, User_SensitiveEncryptHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) WithdrawConfirmHandle(ctx context.Context, in *WithdrawConfirmHandleRequest, opts ...grpc.CallOption) (*WithdrawConfirmHandleReply, error) {
+	out := new(WithdrawConfirmHandleReply)
+	err := c.cc.Invoke(ctx, User_WithdrawConfirmHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error) {
+	out := new(AdminWithdrawListReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawAudit(ctx context.Context, in *AdminWithdrawAuditRequest, opts ...grpc.CallOption) (*AdminWithdrawAuditReply, error) {
+	out := new(AdminWithdrawAuditReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawAudit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawReplace(ctx context.Context, in *AdminWithdrawReplaceRequest, opts ...grpc.CallOption) (*AdminWithdrawReplaceReply, error) {
+	out := new(AdminWithdrawReplaceReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawReplace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardReveal(ctx context.Context, in *AdminCardRevealRequest, opts ...grpc.CallOption) (*AdminCardRevealReply, error) {
+	out := new(AdminCardRevealReply)
+	err := c.cc.Invoke(ctx, User_AdminCardReveal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardRevealLogList(ctx context.Context, in *AdminCardRevealLogListRequest, opts ...grpc.CallOption) (*AdminCardRevealLogListReply, error) {
+	out := new(AdminCardRevealLogListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardRevealLogList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminIssuerJournalList(ctx context.Context, in *AdminIssuerJournalListRequest, opts ...grpc.CallOption) (*AdminIssuerJournalListReply, error) {
+	out := new(AdminIssuerJournalListReply)
+	err := c.cc.Invoke(ctx, User_AdminIssuerJournalList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error) {
 	out := new(AdminRewardListReply)
 	err := c.cc.Invoke(ctx, User_AdminRewardList_FullMethodName, in, out, opts...)
@@ -189,6 +542,49 @@ type UserServer interface {
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
+	// 回调处理
+	CallbackEventHandle(context.Context, *CallbackEventHandleRequest) (*CallbackEventHandleReply, error)
+	AdminCallbackEventList(context.Context, *AdminCallbackEventListRequest) (*AdminCallbackEventListReply, error)
+	// 卡产品同步
+	CardProductSyncHandle(context.Context, *CardProductSyncHandleRequest) (*CardProductSyncHandleReply, error)
+	AdminCardProductList(context.Context, *AdminCardProductListRequest) (*AdminCardProductListReply, error)
+	AdminCardProductEnable(context.Context, *AdminCardProductEnableRequest) (*AdminCardProductEnableReply, error)
+	AdminCardProductUserCardMax(context.Context, *AdminCardProductUserCardMaxRequest) (*AdminCardProductUserCardMaxReply, error)
+	AdminCardProductDefault(context.Context, *AdminCardProductDefaultRequest) (*AdminCardProductDefaultReply, error)
+	// 虚拟卡充值
+	CardRechargeHandle(context.Context, *CardRechargeHandleRequest) (*CardRechargeHandleReply, error)
+	AdminCardRecharge(context.Context, *AdminCardRechargeRequest) (*AdminCardRechargeReply, error)
+	AdminCardRechargeList(context.Context, *AdminCardRechargeListRequest) (*AdminCardRechargeListReply, error)
+	// 卡片操作
+	AdminCardFreeze(context.Context, *AdminCardFreezeRequest) (*AdminCardFreezeReply, error)
+	AdminCardCancel(context.Context, *AdminCardCancelRequest) (*AdminCardCancelReply, error)
+	AdminCardLimit(context.Context, *AdminCardLimitRequest) (*AdminCardLimitReply, error)
+	CardTransactionSyncHandle(context.Context, *CardTransactionSyncHandleRequest) (*CardTransactionSyncHandleReply, error)
+	AdminCardTransactionList(context.Context, *AdminCardTransactionListRequest) (*AdminCardTransactionListReply, error)
+	CardBalanceHandle(context.Context, *CardBalanceHandleRequest) (*CardBalanceHandleReply, error)
+	AdminCardBalanceDriftList(context.Context, *AdminCardBalanceDriftListRequest) (*AdminCardBalanceDriftListReply, error)
+	// 实体卡订单
+	AdminCardTwoOrderList(context.Context, *AdminCardTwoOrderListRequest) (*AdminCardTwoOrderListReply, error)
+	AdminCardTwoOrderStatus(context.Context, *AdminCardTwoOrderStatusRequest) (*AdminCardTwoOrderStatusReply, error)
+	AdminCardTwoOrderAddress(context.Context, *AdminCardTwoOrderAddressRequest) (*AdminCardTwoOrderAddressReply, error)
+	AdminUserCardList(context.Context, *AdminUserCardListRequest) (*AdminUserCardListReply, error)
+	AdminUserCardApply(context.Context, *AdminUserCardApplyRequest) (*AdminUserCardApplyReply, error)
+	AdminUserCardHistoryList(context.Context, *AdminUserCardHistoryListRequest) (*AdminUserCardHistoryListReply, error)
+	// 存量卡号和KYC字段加密
+	SensitiveEncryptHandle(context.Context, *SensitiveEncryptHandleRequest) (*SensitiveEncryptHandleReply, error)
+	// 提现交易确认
+	WithdrawConfirmHandle(context.Context, *WithdrawConfirmHandleRequest) (*WithdrawConfirmHandleReply, error)
+	// 提现列表
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	// 提现人工审核
+	AdminWithdrawAudit(context.Context, *AdminWithdrawAuditRequest) (*AdminWithdrawAuditReply, error)
+	// 卡住的提现交易加速或取消
+	AdminWithdrawReplace(context.Context, *AdminWithdrawReplaceRequest) (*AdminWithdrawReplaceReply, error)
+	// 查看完整卡号，需要超级管理员重新输入密码，每次请求都记录
+	AdminCardReveal(context.Context, *AdminCardRevealRequest) (*AdminCardRevealReply, error)
+	AdminCardRevealLogList(context.Context, *AdminCardRevealLogListRequest) (*AdminCardRevealLogListReply, error)
+	// 发卡方请求日志
+	AdminIssuerJournalList(context.Context, *AdminIssuerJournalListRequest) (*AdminIssuerJournalListReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
@@ -219,6 +615,99 @@ func (UnimplementedUserServer) AdminWithdrawEth(context.Context, *AdminWithdrawE
 func (UnimplementedUserServer) RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardCardTwo not implemented")
 }
+func (UnimplementedUserServer) CallbackEventHandle(context.Context, *CallbackEventHandleRequest) (*CallbackEventHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallbackEventHandle not implemented")
+}
+func (UnimplementedUserServer) AdminCallbackEventList(context.Context, *AdminCallbackEventListRequest) (*AdminCallbackEventListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCallbackEventList not implemented")
+}
+func (UnimplementedUserServer) CardProductSyncHandle(context.Context, *CardProductSyncHandleRequest) (*CardProductSyncHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardProductSyncHandle not implemented")
+}
+func (UnimplementedUserServer) AdminCardProductList(context.Context, *AdminCardProductListRequest) (*AdminCardProductListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardProductList not implemented")
+}
+func (UnimplementedUserServer) AdminCardProductEnable(context.Context, *AdminCardProductEnableRequest) (*AdminCardProductEnableReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardProductEnable not implemented")
+}
+func (UnimplementedUserServer) AdminCardProductUserCardMax(context.Context, *AdminCardProductUserCardMaxRequest) (*AdminCardProductUserCardMaxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardProductUserCardMax not implemented")
+}
+func (UnimplementedUserServer) AdminCardProductDefault(context.Context, *AdminCardProductDefaultRequest) (*AdminCardProductDefaultReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardProductDefault not implemented")
+}
+func (UnimplementedUserServer) CardRechargeHandle(context.Context, *CardRechargeHandleRequest) (*CardRechargeHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardRechargeHandle not implemented")
+}
+func (UnimplementedUserServer) AdminCardRecharge(context.Context, *AdminCardRechargeRequest) (*AdminCardRechargeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardRecharge not implemented")
+}
+func (UnimplementedUserServer) AdminCardRechargeList(context.Context, *AdminCardRechargeListRequest) (*AdminCardRechargeListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardRechargeList not implemented")
+}
+func (UnimplementedUserServer) AdminCardFreeze(context.Context, *AdminCardFreezeRequest) (*AdminCardFreezeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardFreeze not implemented")
+}
+func (UnimplementedUserServer) AdminCardCancel(context.Context, *AdminCardCancelRequest) (*AdminCardCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardCancel not implemented")
+}
+func (UnimplementedUserServer) AdminCardLimit(context.Context, *AdminCardLimitRequest) (*AdminCardLimitReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardLimit not implemented")
+}
+func (UnimplementedUserServer) CardTransactionSyncHandle(context.Context, *CardTransactionSyncHandleRequest) (*CardTransactionSyncHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardTransactionSyncHandle not implemented")
+}
+func (UnimplementedUserServer) AdminCardTransactionList(context.Context, *AdminCardTransactionListRequest) (*AdminCardTransactionListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardTransactionList not implemented")
+}
+func (UnimplementedUserServer) CardBalanceHandle(context.Context, *CardBalanceHandleRequest) (*CardBalanceHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardBalanceHandle not implemented")
+}
+func (UnimplementedUserServer) AdminCardBalanceDriftList(context.Context, *AdminCardBalanceDriftListRequest) (*AdminCardBalanceDriftListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardBalanceDriftList not implemented")
+}
+func (UnimplementedUserServer) AdminCardTwoOrderList(context.Context, *AdminCardTwoOrderListRequest) (*AdminCardTwoOrderListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardTwoOrderList not implemented")
+}
+func (UnimplementedUserServer) AdminCardTwoOrderStatus(context.Context, *AdminCardTwoOrderStatusRequest) (*AdminCardTwoOrderStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardTwoOrderStatus not implemented")
+}
+func (UnimplementedUserServer) AdminCardTwoOrderAddress(context.Context, *AdminCardTwoOrderAddressRequest) (*AdminCardTwoOrderAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardTwoOrderAddress not implemented")
+}
+func (UnimplementedUserServer) AdminUserCardList(context.Context, *AdminUserCardListRequest) (*AdminUserCardListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserCardList not implemented")
+}
+func (UnimplementedUserServer) AdminUserCardApply(context.Context, *AdminUserCardApplyRequest) (*AdminUserCardApplyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserCardApply not implemented")
+}
+func (UnimplementedUserServer) AdminUserCardHistoryList(context.Context, *AdminUserCardHistoryListRequest) (*AdminUserCardHistoryListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserCardHistoryList not implemented")
+}
+func (UnimplementedUserServer) SensitiveEncryptHandle(context.Context, *SensitiveEncryptHandleRequest) (*SensitiveEncryptHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SensitiveEncryptHandle not implemented")
+}
+func (UnimplementedUserServer) WithdrawConfirmHandle(context.Context, *WithdrawConfirmHandleRequest) (*WithdrawConfirmHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawConfirmHandle not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawList not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawAudit(context.Context, *AdminWithdrawAuditRequest) (*AdminWithdrawAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawAudit not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawReplace(context.Context, *AdminWithdrawReplaceRequest) (*AdminWithdrawReplaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReplace not implemented")
+}
+func (UnimplementedUserServer) AdminCardReveal(context.Context, *AdminCardRevealRequest) (*AdminCardRevealReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardReveal not implemented")
+}
+func (UnimplementedUserServer) AdminCardRevealLogList(context.Context, *AdminCardRevealLogListRequest) (*AdminCardRevealLogListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardRevealLogList not implemented")
+}
+func (UnimplementedUserServer) AdminIssuerJournalList(context.Context, *AdminIssuerJournalListRequest) (*AdminIssuerJournalListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminIssuerJournalList not implemented")
+}
 func (UnimplementedUserServer) AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardList not implemented")
 }
@@ -346,143 +835,701 @@ func _User_RewardCardTwo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_AdminRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminRewardListRequest)
+func _User_CallbackEventHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackEventHandleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).AdminRewardList(ctx, in)
+		return srv.(UserServer).CallbackEventHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_AdminRewardList_FullMethodName,
+		FullMethod: User_CallbackEventHandle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).AdminRewardList(ctx, req.(*AdminRewardListRequest))
+		return srv.(UserServer).CallbackEventHandle(ctx, req.(*CallbackEventHandleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_AdminUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUserListRequest)
+func _User_AdminCallbackEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCallbackEventListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).AdminUserList(ctx, in)
+		return srv.(UserServer).AdminCallbackEventList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_AdminUserList_FullMethodName,
+		FullMethod: User_AdminCallbackEventList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).AdminUserList(ctx, req.(*AdminUserListRequest))
+		return srv.(UserServer).AdminCallbackEventList(ctx, req.(*AdminCallbackEventListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_AdminLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminLoginRequest)
+func _User_CardProductSyncHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardProductSyncHandleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).AdminLogin(ctx, in)
+		return srv.(UserServer).CardProductSyncHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_AdminLogin_FullMethodName,
+		FullMethod: User_CardProductSyncHandle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).AdminLogin(ctx, req.(*AdminLoginRequest))
+		return srv.(UserServer).CardProductSyncHandle(ctx, req.(*CardProductSyncHandleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdateCanVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCanVipRequest)
+func _User_AdminCardProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardProductListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdateCanVip(ctx, in)
+		return srv.(UserServer).AdminCardProductList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_UpdateCanVip_FullMethodName,
+		FullMethod: User_AdminCardProductList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdateCanVip(ctx, req.(*UpdateCanVipRequest))
+		return srv.(UserServer).AdminCardProductList(ctx, req.(*AdminCardProductListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetVipThree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetVipThreeRequest)
+func _User_AdminCardProductEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardProductEnableRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetVipThree(ctx, in)
+		return srv.(UserServer).AdminCardProductEnable(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_SetVipThree_FullMethodName,
+		FullMethod: User_AdminCardProductEnable_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetVipThree(ctx, req.(*SetVipThreeRequest))
+		return srv.(UserServer).AdminCardProductEnable(ctx, req.(*AdminCardProductEnableRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_SetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUserCountRequest)
+func _User_AdminCardProductUserCardMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardProductUserCardMaxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).SetUserCount(ctx, in)
+		return srv.(UserServer).AdminCardProductUserCardMax(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_SetUserCount_FullMethodName,
+		FullMethod: User_AdminCardProductUserCardMax_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).SetUserCount(ctx, req.(*SetUserCountRequest))
+		return srv.(UserServer).AdminCardProductUserCardMax(ctx, req.(*AdminCardProductUserCardMaxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_AdminConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminConfigRequest)
+func _User_AdminCardProductDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardProductDefaultRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).AdminConfig(ctx, in)
+		return srv.(UserServer).AdminCardProductDefault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_AdminConfig_FullMethodName,
+		FullMethod: User_AdminCardProductDefault_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).AdminConfig(ctx, req.(*AdminConfigRequest))
+		return srv.(UserServer).AdminCardProductDefault(ctx, req.(*AdminCardProductDefaultRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_AdminConfigUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminConfigUpdateRequest)
+func _User_CardRechargeHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardRechargeHandleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).AdminConfigUpdate(ctx, in)
+		return srv.(UserServer).CardRechargeHandle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_AdminConfigUpdate_FullMethodName,
+		FullMethod: User_CardRechargeHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CardRechargeHandle(ctx, req.(*CardRechargeHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardRecharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardRechargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardRecharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardRecharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardRecharge(ctx, req.(*AdminCardRechargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardRechargeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardRechargeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardRechargeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardRechargeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardRechargeList(ctx, req.(*AdminCardRechargeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardFreeze(ctx, req.(*AdminCardFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardCancel(ctx, req.(*AdminCardCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardLimit(ctx, req.(*AdminCardLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CardTransactionSyncHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardTransactionSyncHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CardTransactionSyncHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CardTransactionSyncHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CardTransactionSyncHandle(ctx, req.(*CardTransactionSyncHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardTransactionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardTransactionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardTransactionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardTransactionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardTransactionList(ctx, req.(*AdminCardTransactionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CardBalanceHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardBalanceHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CardBalanceHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CardBalanceHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CardBalanceHandle(ctx, req.(*CardBalanceHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardBalanceDriftList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardBalanceDriftListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardBalanceDriftList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardBalanceDriftList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardBalanceDriftList(ctx, req.(*AdminCardBalanceDriftListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardTwoOrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardTwoOrderListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardTwoOrderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardTwoOrderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardTwoOrderList(ctx, req.(*AdminCardTwoOrderListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardTwoOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardTwoOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardTwoOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardTwoOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardTwoOrderStatus(ctx, req.(*AdminCardTwoOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardTwoOrderAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardTwoOrderAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardTwoOrderAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardTwoOrderAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardTwoOrderAddress(ctx, req.(*AdminCardTwoOrderAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminUserCardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserCardListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminUserCardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminUserCardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminUserCardList(ctx, req.(*AdminUserCardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminUserCardApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserCardApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminUserCardApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminUserCardApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminUserCardApply(ctx, req.(*AdminUserCardApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminUserCardHistoryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserCardHistoryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminUserCardHistoryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminUserCardHistoryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminUserCardHistoryList(ctx, req.(*AdminUserCardHistoryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SensitiveEncryptHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SensitiveEncryptHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SensitiveEncryptHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SensitiveEncryptHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SensitiveEncryptHandle(ctx, req.(*SensitiveEncryptHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_WithdrawConfirmHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawConfirmHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).WithdrawConfirmHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_WithdrawConfirmHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).WithdrawConfirmHandle(ctx, req.(*WithdrawConfirmHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawAudit(ctx, req.(*AdminWithdrawAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawReplace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawReplace(ctx, req.(*AdminWithdrawReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardReveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardRevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardReveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardReveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardReveal(ctx, req.(*AdminCardRevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardRevealLogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardRevealLogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardRevealLogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardRevealLogList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardRevealLogList(ctx, req.(*AdminCardRevealLogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminIssuerJournalList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminIssuerJournalListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminIssuerJournalList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminIssuerJournalList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminIssuerJournalList(ctx, req.(*AdminIssuerJournalListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminRewardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRewardListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminRewardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminRewardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminRewardList(ctx, req.(*AdminRewardListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminUserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminUserList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminUserList(ctx, req.(*AdminUserListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminLogin(ctx, req.(*AdminLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateCanVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanVipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateCanVip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateCanVip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateCanVip(ctx, req.(*UpdateCanVipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetVipThree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVipThreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetVipThree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetVipThree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetVipThree(ctx, req.(*SetVipThreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetUserCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserCount(ctx, req.(*SetUserCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminConfig(ctx, req.(*AdminConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminConfigUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminConfigUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminConfigUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminConfigUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(UserServer).AdminConfigUpdate(ctx, req.(*AdminConfigUpdateRequest))
@@ -517,6 +1564,130 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardCardTwo",
 			Handler:    _User_RewardCardTwo_Handler,
 		},
+		{
+			MethodName: "CallbackEventHandle",
+			Handler:    _User_CallbackEventHandle_Handler,
+		},
+		{
+			MethodName: "AdminCallbackEventList",
+			Handler:    _User_AdminCallbackEventList_Handler,
+		},
+		{
+			MethodName: "CardProductSyncHandle",
+			Handler:    _User_CardProductSyncHandle_Handler,
+		},
+		{
+			MethodName: "AdminCardProductList",
+			Handler:    _User_AdminCardProductList_Handler,
+		},
+		{
+			MethodName: "AdminCardProductEnable",
+			Handler:    _User_AdminCardProductEnable_Handler,
+		},
+		{
+			MethodName: "AdminCardProductUserCardMax",
+			Handler:    _User_AdminCardProductUserCardMax_Handler,
+		},
+		{
+			MethodName: "AdminCardProductDefault",
+			Handler:    _User_AdminCardProductDefault_Handler,
+		},
+		{
+			MethodName: "CardRechargeHandle",
+			Handler:    _User_CardRechargeHandle_Handler,
+		},
+		{
+			MethodName: "AdminCardRecharge",
+			Handler:    _User_AdminCardRecharge_Handler,
+		},
+		{
+			MethodName: "AdminCardRechargeList",
+			Handler:    _User_AdminCardRechargeList_Handler,
+		},
+		{
+			MethodName: "AdminCardFreeze",
+			Handler:    _User_AdminCardFreeze_Handler,
+		},
+		{
+			MethodName: "AdminCardCancel",
+			Handler:    _User_AdminCardCancel_Handler,
+		},
+		{
+			MethodName: "AdminCardLimit",
+			Handler:    _User_AdminCardLimit_Handler,
+		},
+		{
+			MethodName: "CardTransactionSyncHandle",
+			Handler:    _User_CardTransactionSyncHandle_Handler,
+		},
+		{
+			MethodName: "AdminCardTransactionList",
+			Handler:    _User_AdminCardTransactionList_Handler,
+		},
+		{
+			MethodName: "CardBalanceHandle",
+			Handler:    _User_CardBalanceHandle_Handler,
+		},
+		{
+			MethodName: "AdminCardBalanceDriftList",
+			Handler:    _User_AdminCardBalanceDriftList_Handler,
+		},
+		{
+			MethodName: "AdminCardTwoOrderList",
+			Handler:    _User_AdminCardTwoOrderList_Handler,
+		},
+		{
+			MethodName: "AdminCardTwoOrderStatus",
+			Handler:    _User_AdminCardTwoOrderStatus_Handler,
+		},
+		{
+			MethodName: "AdminCardTwoOrderAddress",
+			Handler:    _User_AdminCardTwoOrderAddress_Handler,
+		},
+		{
+			MethodName: "AdminUserCardList",
+			Handler:    _User_AdminUserCardList_Handler,
+		},
+		{
+			MethodName: "AdminUserCardApply",
+			Handler:    _User_AdminUserCardApply_Handler,
+		},
+		{
+			MethodName: "AdminUserCardHistoryList",
+			Handler:    _User_AdminUserCardHistoryList_Handler,
+		},
+		{
+			MethodName: "SensitiveEncryptHandle",
+			Handler:    _User_SensitiveEncryptHandle_Handler,
+		},
+		{
+			MethodName: "WithdrawConfirmHandle",
+			Handler:    _User_WithdrawConfirmHandle_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawList",
+			Handler:    _User_AdminWithdrawList_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawAudit",
+			Handler:    _User_AdminWithdrawAudit_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReplace",
+			Handler:    _User_AdminWithdrawReplace_Handler,
+		},
+		{
+			MethodName: "AdminCardReveal",
+			Handler:    _User_AdminCardReveal_Handler,
+		},
+		{
+			MethodName: "AdminCardRevealLogList",
+			Handler:    _User_AdminCardRevealLogList_Handler,
+		},
+		{
+			MethodName: "AdminIssuerJournalList",
+			Handler:    _User_AdminIssuerJournalList_Handler,
+		},
 		{
 			MethodName: "AdminRewardList",
 			Handler:    _User_AdminRewardList_Handler,
@@ -19,35 +19,109 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserAdminCallbackEventList = "/api.user.v1.User/AdminCallbackEventList"
+const OperationUserAdminCardBalanceDriftList = "/api.user.v1.User/AdminCardBalanceDriftList"
+const OperationUserAdminCardCancel = "/api.user.v1.User/AdminCardCancel"
+const OperationUserAdminCardFreeze = "/api.user.v1.User/AdminCardFreeze"
+const OperationUserAdminCardLimit = "/api.user.v1.User/AdminCardLimit"
+const OperationUserAdminCardProductDefault = "/api.user.v1.User/AdminCardProductDefault"
+const OperationUserAdminCardProductEnable = "/api.user.v1.User/AdminCardProductEnable"
+const OperationUserAdminCardProductList = "/api.user.v1.User/AdminCardProductList"
+const OperationUserAdminCardProductUserCardMax = "/api.user.v1.User/AdminCardProductUserCardMax"
+const OperationUserAdminCardRecharge = "/api.user.v1.User/AdminCardRecharge"
+const OperationUserAdminCardRechargeList = "/api.user.v1.User/AdminCardRechargeList"
+const OperationUserAdminCardReveal = "/api.user.v1.User/AdminCardReveal"
+const OperationUserAdminCardRevealLogList = "/api.user.v1.User/AdminCardRevealLogList"
+const OperationUserAdminCardTransactionList = "/api.user.v1.User/AdminCardTransactionList"
+const OperationUserAdminCardTwoOrderAddress = "/api.user.v1.User/AdminCardTwoOrderAddress"
+const OperationUserAdminCardTwoOrderList = "/api.user.v1.User/AdminCardTwoOrderList"
+const OperationUserAdminCardTwoOrderStatus = "/api.user.v1.User/AdminCardTwoOrderStatus"
 const OperationUserAdminConfig = "/api.user.v1.User/AdminConfig"
 const OperationUserAdminConfigUpdate = "/api.user.v1.User/AdminConfigUpdate"
+const OperationUserAdminIssuerJournalList = "/api.user.v1.User/AdminIssuerJournalList"
 const OperationUserAdminLogin = "/api.user.v1.User/AdminLogin"
 const OperationUserAdminRewardList = "/api.user.v1.User/AdminRewardList"
+const OperationUserAdminUserCardApply = "/api.user.v1.User/AdminUserCardApply"
+const OperationUserAdminUserCardHistoryList = "/api.user.v1.User/AdminUserCardHistoryList"
+const OperationUserAdminUserCardList = "/api.user.v1.User/AdminUserCardList"
 const OperationUserAdminUserList = "/api.user.v1.User/AdminUserList"
+const OperationUserAdminWithdrawAudit = "/api.user.v1.User/AdminWithdrawAudit"
 const OperationUserAdminWithdrawEth = "/api.user.v1.User/AdminWithdrawEth"
+const OperationUserAdminWithdrawList = "/api.user.v1.User/AdminWithdrawList"
+const OperationUserAdminWithdrawReplace = "/api.user.v1.User/AdminWithdrawReplace"
+const OperationUserCallbackEventHandle = "/api.user.v1.User/CallbackEventHandle"
+const OperationUserCardBalanceHandle = "/api.user.v1.User/CardBalanceHandle"
+const OperationUserCardProductSyncHandle = "/api.user.v1.User/CardProductSyncHandle"
+const OperationUserCardRechargeHandle = "/api.user.v1.User/CardRechargeHandle"
 const OperationUserCardStatusHandle = "/api.user.v1.User/CardStatusHandle"
+const OperationUserCardTransactionSyncHandle = "/api.user.v1.User/CardTransactionSyncHandle"
 const OperationUserDeposit = "/api.user.v1.User/Deposit"
 const OperationUserOpenCardHandle = "/api.user.v1.User/OpenCardHandle"
 const OperationUserRewardCardTwo = "/api.user.v1.User/RewardCardTwo"
+const OperationUserSensitiveEncryptHandle = "/api.user.v1.User/SensitiveEncryptHandle"
 const OperationUserSetUserCount = "/api.user.v1.User/SetUserCount"
 const OperationUserSetVipThree = "/api.user.v1.User/SetVipThree"
 const OperationUserUpdateCanVip = "/api.user.v1.User/UpdateCanVip"
+const OperationUserWithdrawConfirmHandle = "/api.user.v1.User/WithdrawConfirmHandle"
 
 type UserHTTPServer interface {
+	AdminCallbackEventList(context.Context, *AdminCallbackEventListRequest) (*AdminCallbackEventListReply, error)
+	AdminCardBalanceDriftList(context.Context, *AdminCardBalanceDriftListRequest) (*AdminCardBalanceDriftListReply, error)
+	AdminCardCancel(context.Context, *AdminCardCancelRequest) (*AdminCardCancelReply, error)
+	// AdminCardFreeze 卡片操作
+	AdminCardFreeze(context.Context, *AdminCardFreezeRequest) (*AdminCardFreezeReply, error)
+	AdminCardLimit(context.Context, *AdminCardLimitRequest) (*AdminCardLimitReply, error)
+	AdminCardProductDefault(context.Context, *AdminCardProductDefaultRequest) (*AdminCardProductDefaultReply, error)
+	AdminCardProductEnable(context.Context, *AdminCardProductEnableRequest) (*AdminCardProductEnableReply, error)
+	AdminCardProductList(context.Context, *AdminCardProductListRequest) (*AdminCardProductListReply, error)
+	AdminCardProductUserCardMax(context.Context, *AdminCardProductUserCardMaxRequest) (*AdminCardProductUserCardMaxReply, error)
+	AdminCardRecharge(context.Context, *AdminCardRechargeRequest) (*AdminCardRechargeReply, error)
+	AdminCardRechargeList(context.Context, *AdminCardRechargeListRequest) (*AdminCardRechargeListReply, error)
+	// AdminCardReveal 查看完整卡号，需要超级管理员重新输入密码，每次请求都记录
+	AdminCardReveal(context.Context, *AdminCardRevealRequest) (*AdminCardRevealReply, error)
+	AdminCardRevealLogList(context.Context, *AdminCardRevealLogListRequest) (*AdminCardRevealLogListReply, error)
+	AdminCardTransactionList(context.Context, *AdminCardTransactionListRequest) (*AdminCardTransactionListReply, error)
+	AdminCardTwoOrderAddress(context.Context, *AdminCardTwoOrderAddressRequest) (*AdminCardTwoOrderAddressReply, error)
+	// AdminCardTwoOrderList 实体卡订单
+	AdminCardTwoOrderList(context.Context, *AdminCardTwoOrderListRequest) (*AdminCardTwoOrderListReply, error)
+	AdminCardTwoOrderStatus(context.Context, *AdminCardTwoOrderStatusRequest) (*AdminCardTwoOrderStatusReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
+	// AdminIssuerJournalList 发卡方请求日志
+	AdminIssuerJournalList(context.Context, *AdminIssuerJournalListRequest) (*AdminIssuerJournalListReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	AdminUserCardApply(context.Context, *AdminUserCardApplyRequest) (*AdminUserCardApplyReply, error)
+	AdminUserCardHistoryList(context.Context, *AdminUserCardHistoryListRequest) (*AdminUserCardHistoryListReply, error)
+	AdminUserCardList(context.Context, *AdminUserCardListRequest) (*AdminUserCardListReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
+	// AdminWithdrawAudit 提现人工审核
+	AdminWithdrawAudit(context.Context, *AdminWithdrawAuditRequest) (*AdminWithdrawAuditReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	// AdminWithdrawList 提现列表
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	// AdminWithdrawReplace 卡住的提现交易加速或取消
+	AdminWithdrawReplace(context.Context, *AdminWithdrawReplaceRequest) (*AdminWithdrawReplaceReply, error)
+	// CallbackEventHandle 回调处理
+	CallbackEventHandle(context.Context, *CallbackEventHandleRequest) (*CallbackEventHandleReply, error)
+	CardBalanceHandle(context.Context, *CardBalanceHandleRequest) (*CardBalanceHandleReply, error)
+	// CardProductSyncHandle 卡产品同步
+	CardProductSyncHandle(context.Context, *CardProductSyncHandleRequest) (*CardProductSyncHandleReply, error)
+	// CardRechargeHandle 虚拟卡充值
+	CardRechargeHandle(context.Context, *CardRechargeHandleRequest) (*CardRechargeHandleReply, error)
 	CardStatusHandle(context.Context, *CardStatusHandleRequest) (*CardStatusHandleReply, error)
+	CardTransactionSyncHandle(context.Context, *CardTransactionSyncHandleRequest) (*CardTransactionSyncHandleReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	// OpenCardHandle 开卡
 	OpenCardHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error)
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
+	// SensitiveEncryptHandle 存量卡号和KYC字段加密
+	SensitiveEncryptHandle(context.Context, *SensitiveEncryptHandleRequest) (*SensitiveEncryptHandleReply, error)
 	SetUserCount(context.Context, *SetUserCountRequest) (*SetUserCountReply, error)
 	SetVipThree(context.Context, *SetVipThreeRequest) (*SetVipThreeReply, error)
 	UpdateCanVip(context.Context, *UpdateCanVipRequest) (*UpdateCanVipReply, error)
+	// WithdrawConfirmHandle 提现交易确认
+	WithdrawConfirmHandle(context.Context, *WithdrawConfirmHandleRequest) (*WithdrawConfirmHandleReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
@@ -57,6 +131,37 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.GET("/api/admin_dhb/deposit", _User_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _User_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_card_two", _User_RewardCardTwo0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/callback_event_handle", _User_CallbackEventHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/callback_event_list", _User_AdminCallbackEventList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_product_sync_handle", _User_CardProductSyncHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_product_list", _User_AdminCardProductList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_product_enable", _User_AdminCardProductEnable0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_product_user_card_max", _User_AdminCardProductUserCardMax0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_product_default", _User_AdminCardProductDefault0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_recharge_handle", _User_CardRechargeHandle0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_recharge", _User_AdminCardRecharge0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_recharge_list", _User_AdminCardRechargeList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_freeze", _User_AdminCardFreeze0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_cancel", _User_AdminCardCancel0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_limit", _User_AdminCardLimit0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_transaction_sync_handle", _User_CardTransactionSyncHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_transaction_list", _User_AdminCardTransactionList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_balance_handle", _User_CardBalanceHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_balance_drift_list", _User_AdminCardBalanceDriftList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_two_order_list", _User_AdminCardTwoOrderList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_two_order_status", _User_AdminCardTwoOrderStatus0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_two_order_address", _User_AdminCardTwoOrderAddress0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_card_list", _User_AdminUserCardList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/user_card_apply", _User_AdminUserCardApply0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_card_history_list", _User_AdminUserCardHistoryList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/sensitive_encrypt_handle", _User_SensitiveEncryptHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_confirm_handle", _User_WithdrawConfirmHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_list", _User_AdminWithdrawList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_audit", _User_AdminWithdrawAudit0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_replace", _User_AdminWithdrawReplace0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_reveal", _User_AdminCardReveal0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_reveal_log_list", _User_AdminCardRevealLogList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/issuer_journal_list", _User_AdminIssuerJournalList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_list", _User_AdminRewardList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/user_list", _User_AdminUserList0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/login", _User_AdminLogin0_HTTP_Handler(srv))
@@ -162,6 +267,634 @@ func _User_RewardCardTwo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context
 	}
 }
 
+func _User_CallbackEventHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CallbackEventHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCallbackEventHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CallbackEventHandle(ctx, req.(*CallbackEventHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CallbackEventHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCallbackEventList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCallbackEventListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCallbackEventList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCallbackEventList(ctx, req.(*AdminCallbackEventListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCallbackEventListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CardProductSyncHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CardProductSyncHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCardProductSyncHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CardProductSyncHandle(ctx, req.(*CardProductSyncHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CardProductSyncHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardProductList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardProductListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardProductList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardProductList(ctx, req.(*AdminCardProductListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardProductListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardProductEnable0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardProductEnableRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardProductEnable)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardProductEnable(ctx, req.(*AdminCardProductEnableRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardProductEnableReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardProductUserCardMax0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardProductUserCardMaxRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardProductUserCardMax)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardProductUserCardMax(ctx, req.(*AdminCardProductUserCardMaxRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardProductUserCardMaxReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardProductDefault0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardProductDefaultRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardProductDefault)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardProductDefault(ctx, req.(*AdminCardProductDefaultRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardProductDefaultReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CardRechargeHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CardRechargeHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCardRechargeHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CardRechargeHandle(ctx, req.(*CardRechargeHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CardRechargeHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardRecharge0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardRechargeRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardRecharge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardRecharge(ctx, req.(*AdminCardRechargeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardRechargeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardRechargeList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardRechargeListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardRechargeList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardRechargeList(ctx, req.(*AdminCardRechargeListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardRechargeListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardFreeze0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardFreezeRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardFreeze)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardFreeze(ctx, req.(*AdminCardFreezeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardFreezeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardCancel0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardCancelRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardCancel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardCancel(ctx, req.(*AdminCardCancelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardCancelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardLimit0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardLimitRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardLimit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardLimit(ctx, req.(*AdminCardLimitRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardLimitReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CardTransactionSyncHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CardTransactionSyncHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCardTransactionSyncHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CardTransactionSyncHandle(ctx, req.(*CardTransactionSyncHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CardTransactionSyncHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardTransactionList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardTransactionListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardTransactionList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardTransactionList(ctx, req.(*AdminCardTransactionListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardTransactionListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_CardBalanceHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CardBalanceHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCardBalanceHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CardBalanceHandle(ctx, req.(*CardBalanceHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CardBalanceHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardBalanceDriftList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardBalanceDriftListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardBalanceDriftList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardBalanceDriftList(ctx, req.(*AdminCardBalanceDriftListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardBalanceDriftListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardTwoOrderList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardTwoOrderListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardTwoOrderList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardTwoOrderList(ctx, req.(*AdminCardTwoOrderListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardTwoOrderListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardTwoOrderStatus0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardTwoOrderStatusRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardTwoOrderStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardTwoOrderStatus(ctx, req.(*AdminCardTwoOrderStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardTwoOrderStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardTwoOrderAddress0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardTwoOrderAddressRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardTwoOrderAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardTwoOrderAddress(ctx, req.(*AdminCardTwoOrderAddressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardTwoOrderAddressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminUserCardList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserCardListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminUserCardList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserCardList(ctx, req.(*AdminUserCardListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserCardListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminUserCardApply0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserCardApplyRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminUserCardApply)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserCardApply(ctx, req.(*AdminUserCardApplyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserCardApplyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminUserCardHistoryList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserCardHistoryListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminUserCardHistoryList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserCardHistoryList(ctx, req.(*AdminUserCardHistoryListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserCardHistoryListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SensitiveEncryptHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SensitiveEncryptHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSensitiveEncryptHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SensitiveEncryptHandle(ctx, req.(*SensitiveEncryptHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SensitiveEncryptHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_WithdrawConfirmHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawConfirmHandleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdrawConfirmHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawConfirmHandle(ctx, req.(*WithdrawConfirmHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawConfirmHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawAudit0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawAuditRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawAudit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawAudit(ctx, req.(*AdminWithdrawAuditRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawAuditReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawReplace0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReplaceRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawReplace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReplace(ctx, req.(*AdminWithdrawReplaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReplaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardReveal0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardRevealRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardReveal)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardReveal(ctx, req.(*AdminCardRevealRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardRevealReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardRevealLogList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardRevealLogListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardRevealLogList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardRevealLogList(ctx, req.(*AdminCardRevealLogListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardRevealLogListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminIssuerJournalList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminIssuerJournalListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminIssuerJournalList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminIssuerJournalList(ctx, req.(*AdminIssuerJournalListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminIssuerJournalListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_AdminRewardList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminRewardListRequest
@@ -266,91 +999,343 @@ func _User_SetVipThree0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context)
 	}
 }
 
-func _User_SetUserCount0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
-	return func(ctx http.Context) error {
-		var in SetUserCountRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationUserSetUserCount)
-		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.SetUserCount(ctx, req.(*SetUserCountRequest))
-		})
-		out, err := h(ctx, &in)
-		if err != nil {
-			return err
-		}
-		reply := out.(*SetUserCountReply)
-		return ctx.Result(200, reply)
+func _User_SetUserCount0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetUserCountRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetUserCount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetUserCount(ctx, req.(*SetUserCountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetUserCountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminConfig0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminConfigRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminConfig)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminConfig(ctx, req.(*AdminConfigRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminConfigReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminConfigUpdate0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminConfigUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminConfigUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminConfigUpdate(ctx, req.(*AdminConfigUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminConfigUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserHTTPClient interface {
+	AdminCallbackEventList(ctx context.Context, req *AdminCallbackEventListRequest, opts ...http.CallOption) (rsp *AdminCallbackEventListReply, err error)
+	AdminCardBalanceDriftList(ctx context.Context, req *AdminCardBalanceDriftListRequest, opts ...http.CallOption) (rsp *AdminCardBalanceDriftListReply, err error)
+	AdminCardCancel(ctx context.Context, req *AdminCardCancelRequest, opts ...http.CallOption) (rsp *AdminCardCancelReply, err error)
+	AdminCardFreeze(ctx context.Context, req *AdminCardFreezeRequest, opts ...http.CallOption) (rsp *AdminCardFreezeReply, err error)
+	AdminCardLimit(ctx context.Context, req *AdminCardLimitRequest, opts ...http.CallOption) (rsp *AdminCardLimitReply, err error)
+	AdminCardProductDefault(ctx context.Context, req *AdminCardProductDefaultRequest, opts ...http.CallOption) (rsp *AdminCardProductDefaultReply, err error)
+	AdminCardProductEnable(ctx context.Context, req *AdminCardProductEnableRequest, opts ...http.CallOption) (rsp *AdminCardProductEnableReply, err error)
+	AdminCardProductList(ctx context.Context, req *AdminCardProductListRequest, opts ...http.CallOption) (rsp *AdminCardProductListReply, err error)
+	AdminCardProductUserCardMax(ctx context.Context, req *AdminCardProductUserCardMaxRequest, opts ...http.CallOption) (rsp *AdminCardProductUserCardMaxReply, err error)
+	AdminCardRecharge(ctx context.Context, req *AdminCardRechargeRequest, opts ...http.CallOption) (rsp *AdminCardRechargeReply, err error)
+	AdminCardRechargeList(ctx context.Context, req *AdminCardRechargeListRequest, opts ...http.CallOption) (rsp *AdminCardRechargeListReply, err error)
+	AdminCardReveal(ctx context.Context, req *AdminCardRevealRequest, opts ...http.CallOption) (rsp *AdminCardRevealReply, err error)
+	AdminCardRevealLogList(ctx context.Context, req *AdminCardRevealLogListRequest, opts ...http.CallOption) (rsp *AdminCardRevealLogListReply, err error)
+	AdminCardTransactionList(ctx context.Context, req *AdminCardTransactionListRequest, opts ...http.CallOption) (rsp *AdminCardTransactionListReply, err error)
+	AdminCardTwoOrderAddress(ctx context.Context, req *AdminCardTwoOrderAddressRequest, opts ...http.CallOption) (rsp *AdminCardTwoOrderAddressReply, err error)
+	AdminCardTwoOrderList(ctx context.Context, req *AdminCardTwoOrderListRequest, opts ...http.CallOption) (rsp *AdminCardTwoOrderListReply, err error)
+	AdminCardTwoOrderStatus(ctx context.Context, req *AdminCardTwoOrderStatusRequest, opts ...http.CallOption) (rsp *AdminCardTwoOrderStatusReply, err error)
+	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
+	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
+	AdminIssuerJournalList(ctx context.Context, req *AdminIssuerJournalListRequest, opts ...http.CallOption) (rsp *AdminIssuerJournalListReply, err error)
+	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
+	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
+	AdminUserCardApply(ctx context.Context, req *AdminUserCardApplyRequest, opts ...http.CallOption) (rsp *AdminUserCardApplyReply, err error)
+	AdminUserCardHistoryList(ctx context.Context, req *AdminUserCardHistoryListRequest, opts ...http.CallOption) (rsp *AdminUserCardHistoryListReply, err error)
+	AdminUserCardList(ctx context.Context, req *AdminUserCardListRequest, opts ...http.CallOption) (rsp *AdminUserCardListReply, err error)
+	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
+	AdminWithdrawAudit(ctx context.Context, req *AdminWithdrawAuditRequest, opts ...http.CallOption) (rsp *AdminWithdrawAuditReply, err error)
+	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
+	AdminWithdrawReplace(ctx context.Context, req *AdminWithdrawReplaceRequest, opts ...http.CallOption) (rsp *AdminWithdrawReplaceReply, err error)
+	CallbackEventHandle(ctx context.Context, req *CallbackEventHandleRequest, opts ...http.CallOption) (rsp *CallbackEventHandleReply, err error)
+	CardBalanceHandle(ctx context.Context, req *CardBalanceHandleRequest, opts ...http.CallOption) (rsp *CardBalanceHandleReply, err error)
+	CardProductSyncHandle(ctx context.Context, req *CardProductSyncHandleRequest, opts ...http.CallOption) (rsp *CardProductSyncHandleReply, err error)
+	CardRechargeHandle(ctx context.Context, req *CardRechargeHandleRequest, opts ...http.CallOption) (rsp *CardRechargeHandleReply, err error)
+	CardStatusHandle(ctx context.Context, req *CardStatusHandleRequest, opts ...http.CallOption) (rsp *CardStatusHandleReply, err error)
+	CardTransactionSyncHandle(ctx context.Context, req *CardTransactionSyncHandleRequest, opts ...http.CallOption) (rsp *CardTransactionSyncHandleReply, err error)
+	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	OpenCardHandle(ctx context.Context, req *OpenCardHandleRequest, opts ...http.CallOption) (rsp *OpenCardHandleReply, err error)
+	RewardCardTwo(ctx context.Context, req *RewardCardTwoRequest, opts ...http.CallOption) (rsp *RewardCardTwoReply, err error)
+	SensitiveEncryptHandle(ctx context.Context, req *SensitiveEncryptHandleRequest, opts ...http.CallOption) (rsp *SensitiveEncryptHandleReply, err error)
+	SetUserCount(ctx context.Context, req *SetUserCountRequest, opts ...http.CallOption) (rsp *SetUserCountReply, err error)
+	SetVipThree(ctx context.Context, req *SetVipThreeRequest, opts ...http.CallOption) (rsp *SetVipThreeReply, err error)
+	UpdateCanVip(ctx context.Context, req *UpdateCanVipRequest, opts ...http.CallOption) (rsp *UpdateCanVipReply, err error)
+	WithdrawConfirmHandle(ctx context.Context, req *WithdrawConfirmHandleRequest, opts ...http.CallOption) (rsp *WithdrawConfirmHandleReply, err error)
+}
+
+type UserHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewUserHTTPClient(client *http.Client) UserHTTPClient {
+	return &UserHTTPClientImpl{client}
+}
+
+func (c *UserHTTPClientImpl) AdminCallbackEventList(ctx context.Context, in *AdminCallbackEventListRequest, opts ...http.CallOption) (*AdminCallbackEventListReply, error) {
+	var out AdminCallbackEventListReply
+	pattern := "/api/admin_dhb/callback_event_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCallbackEventList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardBalanceDriftList(ctx context.Context, in *AdminCardBalanceDriftListRequest, opts ...http.CallOption) (*AdminCardBalanceDriftListReply, error) {
+	var out AdminCardBalanceDriftListReply
+	pattern := "/api/admin_dhb/card_balance_drift_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardBalanceDriftList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardCancel(ctx context.Context, in *AdminCardCancelRequest, opts ...http.CallOption) (*AdminCardCancelReply, error) {
+	var out AdminCardCancelReply
+	pattern := "/api/admin_dhb/card_cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardCancel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardFreeze(ctx context.Context, in *AdminCardFreezeRequest, opts ...http.CallOption) (*AdminCardFreezeReply, error) {
+	var out AdminCardFreezeReply
+	pattern := "/api/admin_dhb/card_freeze"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardFreeze))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardLimit(ctx context.Context, in *AdminCardLimitRequest, opts ...http.CallOption) (*AdminCardLimitReply, error) {
+	var out AdminCardLimitReply
+	pattern := "/api/admin_dhb/card_limit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardLimit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardProductDefault(ctx context.Context, in *AdminCardProductDefaultRequest, opts ...http.CallOption) (*AdminCardProductDefaultReply, error) {
+	var out AdminCardProductDefaultReply
+	pattern := "/api/admin_dhb/card_product_default"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardProductDefault))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardProductEnable(ctx context.Context, in *AdminCardProductEnableRequest, opts ...http.CallOption) (*AdminCardProductEnableReply, error) {
+	var out AdminCardProductEnableReply
+	pattern := "/api/admin_dhb/card_product_enable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardProductEnable))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardProductList(ctx context.Context, in *AdminCardProductListRequest, opts ...http.CallOption) (*AdminCardProductListReply, error) {
+	var out AdminCardProductListReply
+	pattern := "/api/admin_dhb/card_product_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardProductList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardProductUserCardMax(ctx context.Context, in *AdminCardProductUserCardMaxRequest, opts ...http.CallOption) (*AdminCardProductUserCardMaxReply, error) {
+	var out AdminCardProductUserCardMaxReply
+	pattern := "/api/admin_dhb/card_product_user_card_max"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardProductUserCardMax))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardRecharge(ctx context.Context, in *AdminCardRechargeRequest, opts ...http.CallOption) (*AdminCardRechargeReply, error) {
+	var out AdminCardRechargeReply
+	pattern := "/api/admin_dhb/card_recharge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardRecharge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return &out, err
 }
 
-func _User_AdminConfig0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
-	return func(ctx http.Context) error {
-		var in AdminConfigRequest
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationUserAdminConfig)
-		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminConfig(ctx, req.(*AdminConfigRequest))
-		})
-		out, err := h(ctx, &in)
-		if err != nil {
-			return err
-		}
-		reply := out.(*AdminConfigReply)
-		return ctx.Result(200, reply)
+func (c *UserHTTPClientImpl) AdminCardRechargeList(ctx context.Context, in *AdminCardRechargeListRequest, opts ...http.CallOption) (*AdminCardRechargeListReply, error) {
+	var out AdminCardRechargeListReply
+	pattern := "/api/admin_dhb/card_recharge_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardRechargeList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return &out, err
 }
 
-func _User_AdminConfigUpdate0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
-	return func(ctx http.Context) error {
-		var in AdminConfigUpdateRequest
-		if err := ctx.Bind(&in.SendBody); err != nil {
-			return err
-		}
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationUserAdminConfigUpdate)
-		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AdminConfigUpdate(ctx, req.(*AdminConfigUpdateRequest))
-		})
-		out, err := h(ctx, &in)
-		if err != nil {
-			return err
-		}
-		reply := out.(*AdminConfigUpdateReply)
-		return ctx.Result(200, reply)
+func (c *UserHTTPClientImpl) AdminCardReveal(ctx context.Context, in *AdminCardRevealRequest, opts ...http.CallOption) (*AdminCardRevealReply, error) {
+	var out AdminCardRevealReply
+	pattern := "/api/admin_dhb/card_reveal"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardReveal))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return &out, err
 }
 
-type UserHTTPClient interface {
-	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
-	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
-	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
-	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
-	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
-	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
-	CardStatusHandle(ctx context.Context, req *CardStatusHandleRequest, opts ...http.CallOption) (rsp *CardStatusHandleReply, err error)
-	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
-	OpenCardHandle(ctx context.Context, req *OpenCardHandleRequest, opts ...http.CallOption) (rsp *OpenCardHandleReply, err error)
-	RewardCardTwo(ctx context.Context, req *RewardCardTwoRequest, opts ...http.CallOption) (rsp *RewardCardTwoReply, err error)
-	SetUserCount(ctx context.Context, req *SetUserCountRequest, opts ...http.CallOption) (rsp *SetUserCountReply, err error)
-	SetVipThree(ctx context.Context, req *SetVipThreeRequest, opts ...http.CallOption) (rsp *SetVipThreeReply, err error)
-	UpdateCanVip(ctx context.Context, req *UpdateCanVipRequest, opts ...http.CallOption) (rsp *UpdateCanVipReply, err error)
+func (c *UserHTTPClientImpl) AdminCardRevealLogList(ctx context.Context, in *AdminCardRevealLogListRequest, opts ...http.CallOption) (*AdminCardRevealLogListReply, error) {
+	var out AdminCardRevealLogListReply
+	pattern := "/api/admin_dhb/card_reveal_log_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardRevealLogList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-type UserHTTPClientImpl struct {
-	cc *http.Client
+func (c *UserHTTPClientImpl) AdminCardTransactionList(ctx context.Context, in *AdminCardTransactionListRequest, opts ...http.CallOption) (*AdminCardTransactionListReply, error) {
+	var out AdminCardTransactionListReply
+	pattern := "/api/admin_dhb/card_transaction_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardTransactionList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-func NewUserHTTPClient(client *http.Client) UserHTTPClient {
-	return &UserHTTPClientImpl{client}
+func (c *UserHTTPClientImpl) AdminCardTwoOrderAddress(ctx context.Context, in *AdminCardTwoOrderAddressRequest, opts ...http.CallOption) (*AdminCardTwoOrderAddressReply, error) {
+	var out AdminCardTwoOrderAddressReply
+	pattern := "/api/admin_dhb/card_two_order_address"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardTwoOrderAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardTwoOrderList(ctx context.Context, in *AdminCardTwoOrderListRequest, opts ...http.CallOption) (*AdminCardTwoOrderListReply, error) {
+	var out AdminCardTwoOrderListReply
+	pattern := "/api/admin_dhb/card_two_order_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardTwoOrderList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardTwoOrderStatus(ctx context.Context, in *AdminCardTwoOrderStatusRequest, opts ...http.CallOption) (*AdminCardTwoOrderStatusReply, error) {
+	var out AdminCardTwoOrderStatusReply
+	pattern := "/api/admin_dhb/card_two_order_status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardTwoOrderStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
 func (c *UserHTTPClientImpl) AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...http.CallOption) (*AdminConfigReply, error) {
@@ -379,6 +1364,19 @@ func (c *UserHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminCon
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminIssuerJournalList(ctx context.Context, in *AdminIssuerJournalListRequest, opts ...http.CallOption) (*AdminIssuerJournalListReply, error) {
+	var out AdminIssuerJournalListReply
+	pattern := "/api/admin_dhb/issuer_journal_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminIssuerJournalList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
 	var out AdminLoginReply
 	pattern := "/api/admin_dhb/login"
@@ -405,6 +1403,45 @@ func (c *UserHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminRewar
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminUserCardApply(ctx context.Context, in *AdminUserCardApplyRequest, opts ...http.CallOption) (*AdminUserCardApplyReply, error) {
+	var out AdminUserCardApplyReply
+	pattern := "/api/admin_dhb/user_card_apply"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminUserCardApply))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminUserCardHistoryList(ctx context.Context, in *AdminUserCardHistoryListRequest, opts ...http.CallOption) (*AdminUserCardHistoryListReply, error) {
+	var out AdminUserCardHistoryListReply
+	pattern := "/api/admin_dhb/user_card_history_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminUserCardHistoryList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminUserCardList(ctx context.Context, in *AdminUserCardListRequest, opts ...http.CallOption) (*AdminUserCardListReply, error) {
+	var out AdminUserCardListReply
+	pattern := "/api/admin_dhb/user_card_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminUserCardList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...http.CallOption) (*AdminUserListReply, error) {
 	var out AdminUserListReply
 	pattern := "/api/admin_dhb/user_list"
@@ -418,6 +1455,19 @@ func (c *UserHTTPClientImpl) AdminUserList(ctx context.Context, in *AdminUserLis
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminWithdrawAudit(ctx context.Context, in *AdminWithdrawAuditRequest, opts ...http.CallOption) (*AdminWithdrawAuditReply, error) {
+	var out AdminWithdrawAuditReply
+	pattern := "/api/admin_dhb/withdraw_audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawAudit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...http.CallOption) (*AdminWithdrawEthReply, error) {
 	var out AdminWithdrawEthReply
 	pattern := "/api/admin_dhb/withdraw_eth"
@@ -431,6 +1481,84 @@ func (c *UserHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...http.CallOption) (*AdminWithdrawListReply, error) {
+	var out AdminWithdrawListReply
+	pattern := "/api/admin_dhb/withdraw_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminWithdrawReplace(ctx context.Context, in *AdminWithdrawReplaceRequest, opts ...http.CallOption) (*AdminWithdrawReplaceReply, error) {
+	var out AdminWithdrawReplaceReply
+	pattern := "/api/admin_dhb/withdraw_replace"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawReplace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CallbackEventHandle(ctx context.Context, in *CallbackEventHandleRequest, opts ...http.CallOption) (*CallbackEventHandleReply, error) {
+	var out CallbackEventHandleReply
+	pattern := "/api/admin_dhb/callback_event_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCallbackEventHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CardBalanceHandle(ctx context.Context, in *CardBalanceHandleRequest, opts ...http.CallOption) (*CardBalanceHandleReply, error) {
+	var out CardBalanceHandleReply
+	pattern := "/api/admin_dhb/card_balance_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCardBalanceHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CardProductSyncHandle(ctx context.Context, in *CardProductSyncHandleRequest, opts ...http.CallOption) (*CardProductSyncHandleReply, error) {
+	var out CardProductSyncHandleReply
+	pattern := "/api/admin_dhb/card_product_sync_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCardProductSyncHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) CardRechargeHandle(ctx context.Context, in *CardRechargeHandleRequest, opts ...http.CallOption) (*CardRechargeHandleReply, error) {
+	var out CardRechargeHandleReply
+	pattern := "/api/admin_dhb/card_recharge_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCardRechargeHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) CardStatusHandle(ctx context.Context, in *CardStatusHandleRequest, opts ...http.CallOption) (*CardStatusHandleReply, error) {
 	var out CardStatusHandleReply
 	pattern := "/api/admin_dhb/card_status_handle"
@@ -444,6 +1572,19 @@ func (c *UserHTTPClientImpl) CardStatusHandle(ctx context.Context, in *CardStatu
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) CardTransactionSyncHandle(ctx context.Context, in *CardTransactionSyncHandleRequest, opts ...http.CallOption) (*CardTransactionSyncHandleReply, error) {
+	var out CardTransactionSyncHandleReply
+	pattern := "/api/admin_dhb/card_transaction_sync_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCardTransactionSyncHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, opts ...http.CallOption) (*DepositReply, error) {
 	var out DepositReply
 	pattern := "/api/admin_dhb/deposit"
@@ -483,6 +1624,19 @@ func (c *UserHTTPClientImpl) RewardCardTwo(ctx context.Context, in *RewardCardTw
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) SensitiveEncryptHandle(ctx context.Context, in *SensitiveEncryptHandleRequest, opts ...http.CallOption) (*SensitiveEncryptHandleReply, error) {
+	var out SensitiveEncryptHandleReply
+	pattern := "/api/admin_dhb/sensitive_encrypt_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserSensitiveEncryptHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) SetUserCount(ctx context.Context, in *SetUserCountRequest, opts ...http.CallOption) (*SetUserCountReply, error) {
 	var out SetUserCountReply
 	pattern := "/api/admin_dhb/set_user_count"
@@ -521,3 +1675,16 @@ func (c *UserHTTPClientImpl) UpdateCanVip(ctx context.Context, in *UpdateCanVipR
 	}
 	return &out, err
 }
+
+func (c *UserHTTPClientImpl) WithdrawConfirmHandle(ctx context.Context, in *WithdrawConfirmHandleRequest, opts ...http.CallOption) (*WithdrawConfirmHandleReply, error) {
+	var out WithdrawConfirmHandleReply
+	pattern := "/api/admin_dhb/withdraw_confirm_handle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserWithdrawConfirmHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
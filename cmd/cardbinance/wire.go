@@ -9,6 +9,8 @@ import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
 	"cardbinance/internal/data"
+	"cardbinance/internal/data/chain"
+	"cardbinance/internal/data/ispay"
 	"cardbinance/internal/server"
 	"cardbinance/internal/service"
 
@@ -18,6 +20,6 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.Auth, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+func wireApp(*conf.Server, *conf.Data, *conf.Auth, *conf.CardIssuer, *conf.Chain, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, ispay.ProviderSet, chain.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -10,6 +10,11 @@ import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
 	"cardbinance/internal/data"
+	"cardbinance/internal/data/chain"
+	"cardbinance/internal/data/ispay"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
 	"cardbinance/internal/server"
 	"cardbinance/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -23,7 +28,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, cardIssuer *conf.CardIssuer, confChain *conf.Chain, logger log.Logger) (*kratos.App, func(), error) {
 	grpcServer := server.NewGRPCServer(confServer, logger)
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
@@ -33,11 +38,26 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
 	transaction := data.NewTransaction(dataData)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, logger)
-	userService := service.NewUserService(userUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, userService, logger)
+	cardProvider := ispay.NewCardProvider(cardIssuer, userRepo, logger)
+	pool, cleanup2, err := rpcpool.NewPool(confChain, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	signerSigner, err := signer.NewSigner(confChain)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policy := gas.NewPolicy(confChain)
+	chainProvider := chain.NewChainProvider(confChain, pool, signerSigner, policy, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, cardProvider, chainProvider, logger)
+	userService := service.NewUserService(userUseCase, logger, auth, pool, signerSigner, policy, confChain)
+	httpServer := server.NewHTTPServer(confServer, cardIssuer, userService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
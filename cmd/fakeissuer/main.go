@@ -0,0 +1,47 @@
+package main
+
+import (
+	"cardbinance/internal/pkg/fakeissuer"
+	"flag"
+	"fmt"
+	"net/http"
+)
+
+// 本地模拟 ispay，联调时把 card_issuer.base_url 指向这里
+//
+//	go run ./cmd/fakeissuer -addr 127.0.0.1:9102 -callback http://127.0.0.1:8000/api/admin_dhb/callback
+//
+// 控制接口：
+//
+//	POST /fake/holders         {"status":"active"}            新增持卡人
+//	POST /fake/holders/status  {"id":"...","status":"active"} 修改持卡人状态
+//	GET  /fake/cards                                          全部卡片
+//	POST /fake/cards/status    {"id":"...","status":"ACTIVE"} 修改卡片状态
+//	GET  /fake/transactions                                   全部交易
+//	POST /fake/transactions    {"cardId":"...","amount":"9.9"} 新增交易，带 transactionId 时修改状态
+//	POST /fake/callback        {"eventType":"vcc.card.create.fail","data":{...}} 推送回调
+var (
+	addr        string
+	merchantId  string
+	signKey     string
+	callbackUrl string
+)
+
+func init() {
+	flag.StringVar(&addr, "addr", "127.0.0.1:9102", "listen address")
+	flag.StringVar(&merchantId, "merchant", "322338", "merchant id")
+	flag.StringVar(&signKey, "key", "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9", "sign key")
+	flag.StringVar(&callbackUrl, "callback", "http://127.0.0.1:8000/api/admin_dhb/callback", "callback url")
+}
+
+func main() {
+	flag.Parse()
+
+	s := fakeissuer.NewServer(merchantId, signKey)
+	s.CallbackUrl = callbackUrl
+
+	fmt.Println("fake issuer listening on", addr)
+	if err := http.ListenAndServe(addr, s); err != nil {
+		panic(err)
+	}
+}
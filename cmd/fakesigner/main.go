@@ -0,0 +1,44 @@
+package main
+
+import (
+	"cardbinance/internal/pkg/fakesigner"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// 本地模拟远程签名服务，联调时把 chain.signer.type 设为 remote，
+// remote_url 指向这里，remote_address 填启动时打印的地址
+//
+//	HOT_WALLET_PRIVATE_KEY=... HOT_WALLET_SIGNER_TOKEN=... go run ./cmd/fakesigner -addr 127.0.0.1:9103
+//
+// 未设置私钥时随机生成，每次启动地址不同
+//
+//	GET  /address                                     签名地址
+//	POST /sign     {"chainId":"97","from":"0x...","tx":"0x..."} 签名
+var (
+	addr     string
+	keyEnv   string
+	tokenEnv string
+)
+
+func init() {
+	flag.StringVar(&addr, "addr", "127.0.0.1:9103", "listen address")
+	flag.StringVar(&keyEnv, "key_env", "HOT_WALLET_PRIVATE_KEY", "private key env")
+	flag.StringVar(&tokenEnv, "token_env", "HOT_WALLET_SIGNER_TOKEN", "bearer token env")
+}
+
+func main() {
+	flag.Parse()
+
+	s, err := fakesigner.NewServer(os.Getenv(keyEnv), os.Getenv(tokenEnv))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("fake signer", s.Address().Hex(), "listening on", addr)
+	if err = http.ListenAndServe(addr, s); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,485 @@
+package biz_test
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/fakerepo"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func createCardOk(cardId string) *biz.CreateCardResponse {
+	res := &biz.CreateCardResponse{Code: 200}
+	res.Data.CardID = cardId
+	res.Data.CardOrderID = "order-" + cardId
+	return res
+}
+
+func cardInfo(cardId string, status string, pan string) *biz.CardInfoResponse {
+	res := &biz.CardInfoResponse{Code: 200}
+	res.Data.CardID = cardId
+	res.Data.CardStatus = status
+	res.Data.Pan = pan
+	return res
+}
+
+// newCardRepo 用户 1、2 已通过持卡人审核（持卡人id 10001、10002），各有一张已扣费待开的主卡 1、2
+// 用户 3 是 1 的直推人 vip5，用户 4 是 3 的上级 vip10
+func newCardRepo() *fakerepo.Repo {
+	repo := fakerepo.New()
+	repo.AddProduct(&biz.CardProductInfo{ProductId: "1001", ProductStatus: "ENABLED", Enable: 1, MaxCardQuota: 10})
+
+	for id := uint64(1); id <= 2; id++ {
+		repo.AddUser(&biz.User{
+			ID:             id,
+			Address:        fmt.Sprintf("0xuser%d", id),
+			Card:           "no",
+			CardNumber:     "no",
+			CardOrderId:    "do",
+			CardUserId:     fmt.Sprintf("1000%d", id),
+			CardUserStatus: biz.CardHolderActive,
+			ProductId:      "1001",
+		}, "D4D3")
+		repo.AddCard(&biz.UserCard{
+			ID:          id,
+			UserId:      id,
+			ProductId:   "1001",
+			Status:      biz.UserCardApplied,
+			Main:        1,
+			Fee:         10,
+			FeeRewardId: id,
+		})
+	}
+	repo.AddUser(&biz.User{ID: 3, Address: "0xuser3", Vip: 5, CardOrderId: "no"}, "D4")
+	repo.AddUser(&biz.User{ID: 4, Address: "0xuser4", Vip: 10, CardOrderId: "no"}, "")
+
+	return repo
+}
+
+func TestOpenCardHandle(t *testing.T) {
+	tests := []struct {
+		name       string
+		create     map[uint64]*biz.CreateCardResponse
+		createErr  map[uint64]error
+		failCard   uint64
+		wantStatus [2]string
+		wantAmount [2]float64
+	}{
+		{
+			name:       "both cards open",
+			create:     map[uint64]*biz.CreateCardResponse{10001: createCardOk("card-1"), 10002: createCardOk("card-2")},
+			wantStatus: [2]string{biz.UserCardOpening, biz.UserCardOpening},
+		},
+		{
+			// 超时等结果未知的不退款，停在建卡中等对账
+			name:       "issuer timeout keeps card creating",
+			create:     map[uint64]*biz.CreateCardResponse{10002: createCardOk("card-2")},
+			createErr:  map[uint64]error{10001: fakerepo.ErrUpdate},
+			wantStatus: [2]string{biz.UserCardCreating, biz.UserCardOpening},
+		},
+		{
+			name:       "request not sent returns card to applied",
+			create:     map[uint64]*biz.CreateCardResponse{10002: createCardOk("card-2")},
+			createErr:  map[uint64]error{10001: fmt.Errorf("issuer circuit open: %w", biz.ErrIssuerNotSent)},
+			wantStatus: [2]string{biz.UserCardApplied, biz.UserCardOpening},
+		},
+		{
+			name:       "issuer http rejection refunds fee",
+			create:     map[uint64]*biz.CreateCardResponse{10002: createCardOk("card-2")},
+			createErr:  map[uint64]error{10001: fmt.Errorf("%w: http status not ok: 400", biz.ErrIssuerRejected)},
+			wantStatus: [2]string{biz.UserCardFailed, biz.UserCardOpening},
+			wantAmount: [2]float64{10, 0},
+		},
+		{
+			name:       "issuer rejects refunds fee",
+			create:     map[uint64]*biz.CreateCardResponse{10001: {Code: 500, Msg: "rejected"}, 10002: createCardOk("card-2")},
+			wantStatus: [2]string{biz.UserCardFailed, biz.UserCardOpening},
+			wantAmount: [2]float64{10, 0},
+		},
+		{
+			name:       "issuer without card id refunds fee",
+			create:     map[uint64]*biz.CreateCardResponse{10001: {Code: 200}, 10002: createCardOk("card-2")},
+			wantStatus: [2]string{biz.UserCardFailed, biz.UserCardOpening},
+			wantAmount: [2]float64{10, 0},
+		},
+		{
+			// 第一张卡发卡方已建卡但落库失败，停在建卡中等下次对账，第二张照常开
+			name:       "write error keeps card creating",
+			create:     map[uint64]*biz.CreateCardResponse{10001: createCardOk("card-1"), 10002: createCardOk("card-2")},
+			failCard:   1,
+			wantStatus: [2]string{biz.UserCardCreating, biz.UserCardOpening},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCardRepo()
+			repo.FailCardStatus(tt.failCard, biz.UserCardOpening)
+
+			card := fakerepo.NewCard()
+			for k, v := range tt.create {
+				card.Create[k] = v
+			}
+			for k, v := range tt.createErr {
+				card.CreateErr[k] = v
+			}
+
+			uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+			if err := uuc.OpenCardHandle(context.Background()); nil != err {
+				t.Fatal(err)
+			}
+
+			for i, id := range []uint64{1, 2} {
+				c := repo.SavedCard(id)
+				if tt.wantStatus[i] != c.Status {
+					t.Fatalf("card %d = %s, want %s", id, c.Status, tt.wantStatus[i])
+				}
+				u := repo.SavedUser(id)
+				if tt.wantAmount[i] != u.Amount {
+					t.Fatalf("user %d amount = %f, want %f", id, u.Amount, tt.wantAmount[i])
+				}
+				if biz.UserCardOpening == c.Status && (fmt.Sprintf("card-%d", id) != c.CardId || c.CardId != u.Card || c.CardOrderId != u.CardOrderId) {
+					t.Fatalf("card %d = %+v, user card %s order %s", id, c, u.Card, u.CardOrderId)
+				}
+				if biz.UserCardFailed == c.Status && "no" != u.CardOrderId {
+					t.Fatalf("user %d card order = %s after refund, want no", id, u.CardOrderId)
+				}
+			}
+		})
+	}
+}
+
+// TestOpenCardHandleTimeoutCheck 建卡超时但发卡方已建卡的，对账后开卡中，不退款也不重复建卡
+func TestOpenCardHandleTimeoutCheck(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newCardRepo()
+	card := fakerepo.NewCard()
+	card.Create[10001] = createCardOk("card-1")
+	card.CreateErr[10001] = fakerepo.ErrUpdate
+	card.Create[10002] = createCardOk("card-2")
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+	for i := 0; i < 2; i++ {
+		if err := uuc.OpenCardHandle(ctx); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	c, u := repo.SavedCard(1), repo.SavedUser(1)
+	if biz.UserCardOpening != c.Status || "card-1" != c.CardId || 0 != u.Amount {
+		t.Fatalf("card 1 = %+v, user amount %f", c, u.Amount)
+	}
+	if 2 != card.Created {
+		t.Fatalf("create called %d times, want 2", card.Created)
+	}
+}
+
+// TestOpenCardHandleSubmitHolder 提交持卡人结果未知的，下次按 referenceCode 查询，不重复提交
+func TestOpenCardHandleSubmitHolder(t *testing.T) {
+	holderOk := &biz.CreateCardholderResponse{Code: 200}
+	holderOk.Data.HolderID = "20001"
+
+	tests := []struct {
+		name        string
+		holder      *biz.CreateCardholderResponse
+		holderErr   error
+		wantStatus  [2]string // 两次任务后的持卡人状态
+		wantSubmits int
+		wantAmount  float64
+	}{
+		{
+			name:        "submitted",
+			holder:      holderOk,
+			wantStatus:  [2]string{biz.CardHolderPending, biz.CardHolderPending},
+			wantSubmits: 1,
+		},
+		{
+			// 发卡方已建持卡人但响应丢失，查询到后进入审核中
+			name:        "timeout found by reference",
+			holder:      holderOk,
+			holderErr:   fakerepo.ErrUpdate,
+			wantStatus:  [2]string{biz.CardHolderSubmitted, biz.CardHolderPending},
+			wantSubmits: 1,
+		},
+		{
+			// 请求没有到发卡方，查无订单的用原 referenceCode 重新提交
+			name:        "not sent resubmits same reference",
+			holderErr:   fmt.Errorf("issuer circuit open: %w", biz.ErrIssuerNotSent),
+			wantStatus:  [2]string{biz.CardHolderSubmitted, biz.CardHolderSubmitted},
+			wantSubmits: 2,
+		},
+		{
+			name:        "rejected refunds fee",
+			holderErr:   fmt.Errorf("%w: http status not ok: 400", biz.ErrIssuerRejected),
+			wantStatus:  [2]string{biz.CardHolderRejected, biz.CardHolderRejected},
+			wantSubmits: 1, // 开卡费已退，没有待开的卡不再提交
+			wantAmount:  10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			repo := newCardRepo()
+			repo.AddProductDefault(0, "1001")
+			u := repo.SavedUser(1)
+			u.CardUserId = ""
+			u.CardUserStatus = biz.CardHolderNone
+			repo.AddUser(&u, "D4D3")
+			repo.AddCard(&biz.UserCard{ID: 2, UserId: 2, Status: biz.UserCardFailed})
+
+			card := fakerepo.NewCard()
+			card.Holder[1] = tt.holder
+			card.HolderErr[1] = tt.holderErr
+
+			uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+			for i := 0; i < 2; i++ {
+				if err := uuc.OpenCardHandle(ctx); nil != err {
+					t.Fatal(err)
+				}
+				if u = repo.SavedUser(1); tt.wantStatus[i] != u.CardUserStatus {
+					t.Fatalf("run %d holder status = %s, want %s", i+1, u.CardUserStatus, tt.wantStatus[i])
+				}
+			}
+
+			if tt.wantSubmits != len(card.HolderCreated) {
+				t.Fatalf("submits = %v, want %d", card.HolderCreated, tt.wantSubmits)
+			}
+			if card.HolderCreated[0] != card.HolderCreated[len(card.HolderCreated)-1] {
+				t.Fatalf("resubmitted with new reference %v", card.HolderCreated)
+			}
+			if biz.CardHolderPending == u.CardUserStatus && "20001" != u.CardUserId {
+				t.Fatalf("holder id = %s, want 20001", u.CardUserId)
+			}
+			if tt.wantAmount != u.Amount {
+				t.Fatalf("amount = %f, want %f", u.Amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+// TestOpenCardHandleLegacyRefund 没有关联扣费记录的历史卡片，发卡方拒绝时按扣费记录或原固定金额退款，不按当前配置
+func TestOpenCardHandleLegacyRefund(t *testing.T) {
+	tests := []struct {
+		name       string
+		vipTwo     uint64
+		feeReward  *biz.Reward
+		wantAmount float64
+	}{
+		{name: "old zone fixed fee", wantAmount: 10},
+		{name: "new zone fixed fee", vipTwo: 1, wantAmount: 30},
+		{name: "unlinked fee reward", vipTwo: 1, feeReward: &biz.Reward{ID: 9, UserId: 1, Amount: 25, Reason: 3}, wantAmount: 25},
+		{name: "fee reward of another user", feeReward: &biz.Reward{ID: 9, UserId: 2, Amount: 25, Reason: 3}, wantAmount: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCardRepo()
+			u := repo.SavedUser(1)
+			u.VipTwo = tt.vipTwo
+			repo.AddUser(&u, "D4D3")
+			repo.AddCard(&biz.UserCard{ID: 1, UserId: 1, Status: biz.UserCardApplied, Main: 1})
+			if nil != tt.feeReward {
+				repo.AddFeeReward(tt.feeReward)
+			}
+
+			card := fakerepo.NewCard()
+			card.Create[10001] = &biz.CreateCardResponse{Code: 500, Msg: "rejected"}
+			card.Create[10002] = createCardOk("card-2")
+
+			uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+			if err := uuc.OpenCardHandle(context.Background()); nil != err {
+				t.Fatal(err)
+			}
+
+			if c := repo.SavedCard(1); biz.UserCardFailed != c.Status {
+				t.Fatalf("card 1 = %s, want failed", c.Status)
+			}
+			if amount := repo.SavedUser(1).Amount; tt.wantAmount != amount {
+				t.Fatalf("amount = %f, want %f", amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+// TestOpenCardHandleCreatingCheck 建卡中的卡按 referenceCode 查发卡方订单落库，不重复建卡
+func TestOpenCardHandleCreatingCheck(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newCardRepo()
+	repo.FailCardStatus(1, biz.UserCardOpening)
+	card := fakerepo.NewCard()
+	card.Create[10001] = createCardOk("card-1")
+	card.Create[10002] = createCardOk("card-2")
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(1); biz.UserCardCreating != c.Status || "" != c.CardId || "do" != repo.SavedUser(1).CardOrderId {
+		t.Fatalf("card 1 = %+v, user card order %s, want creating without card id", c, repo.SavedUser(1).CardOrderId)
+	}
+
+	// 对账查询失败的继续等
+	card.OrderErr = fakerepo.ErrUpdate
+	repo.FailCardStatus(1, "")
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(1); biz.UserCardCreating != c.Status {
+		t.Fatalf("card 1 = %s after query error, want creating", c.Status)
+	}
+
+	card.OrderErr = nil
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	c, u := repo.SavedCard(1), repo.SavedUser(1)
+	if biz.UserCardOpening != c.Status || "card-1" != c.CardId || "card-1" != u.Card || "order-card-1" != u.CardOrderId {
+		t.Fatalf("card 1 = %+v, user card %s order %s", c, u.Card, u.CardOrderId)
+	}
+	if 2 != card.Created {
+		t.Fatalf("create called %d times, want 2", card.Created)
+	}
+}
+
+// TestOpenCardHandleCreatingNotFound 发卡方查无建卡订单的退回已申请，下次重新建卡
+func TestOpenCardHandleCreatingNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newCardRepo()
+	repo.AddCard(&biz.UserCard{ID: 3, UserId: 1, ProductId: "1001", Status: biz.UserCardCreating, Fee: 10, FeeRewardId: 3})
+	card := fakerepo.NewCard()
+	card.Create[10001] = createCardOk("card-1")
+	card.Create[10002] = createCardOk("card-2")
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(3); biz.UserCardApplied != c.Status {
+		t.Fatalf("card 3 = %s, want applied", c.Status)
+	}
+	if 2 != card.Created {
+		t.Fatalf("create called %d times, want 2", card.Created)
+	}
+}
+
+func TestCardStatusHandle(t *testing.T) {
+	const pan = "4111111111111111"
+
+	tests := []struct {
+		name        string
+		info        *biz.CardInfoResponse
+		infoErr     error
+		wantStatus  string
+		wantNumber  string
+		wantAmount  float64
+		wantRewards []fakerepo.Reward
+	}{
+		{name: "pending stays opening", info: cardInfo("card-1", "PENDING", ""), wantStatus: biz.UserCardOpening, wantNumber: "no"},
+		{name: "progress stays opening", info: cardInfo("card-1", "PROGRESS", ""), wantStatus: biz.UserCardOpening, wantNumber: "no"},
+		{name: "query error stays opening", infoErr: fakerepo.ErrUpdate, wantStatus: biz.UserCardOpening, wantNumber: "no"},
+		{
+			// 直推人 vip5 得 5，上级 vip10 得极差 5
+			name:       "active stores pan and rewards",
+			info:       cardInfo("card-1", "ACTIVE", pan),
+			wantStatus: biz.UserCardActive,
+			wantNumber: pan,
+			wantRewards: []fakerepo.Reward{
+				{UserId: 3, Amount: 5, Vip: 5, Address: "0xuser1"},
+				{UserId: 4, Amount: 5, Vip: 10, Address: "0xuser1"},
+			},
+		},
+		{name: "failed refunds fee", info: cardInfo("card-1", "FAILED", ""), wantStatus: biz.UserCardFailed, wantNumber: "no", wantAmount: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newCardRepo()
+			card := fakerepo.NewCard()
+			card.Create[10001] = createCardOk("card-1")
+			card.CreateErr[10002] = fakerepo.ErrUpdate
+
+			uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+			if err := uuc.OpenCardHandle(context.Background()); nil != err {
+				t.Fatal(err)
+			}
+			if c := repo.SavedCard(1); biz.UserCardOpening != c.Status {
+				t.Fatalf("card 1 = %s after open, want opening", c.Status)
+			}
+
+			card.Info["card-1"] = tt.info
+			card.InfoErr = tt.infoErr
+			if err := uuc.CardStatusHandle(context.Background()); nil != err {
+				t.Fatal(err)
+			}
+
+			c := repo.SavedCard(1)
+			u := repo.SavedUser(1)
+			if tt.wantStatus != c.Status {
+				t.Fatalf("card = %s, want %s", c.Status, tt.wantStatus)
+			}
+			if tt.wantNumber != u.CardNumber || (biz.UserCardActive == c.Status && tt.wantNumber != c.CardNumber) {
+				t.Fatalf("card number user %s card %s, want %s", u.CardNumber, c.CardNumber, tt.wantNumber)
+			}
+			if tt.wantAmount != u.Amount {
+				t.Fatalf("amount = %f, want %f", u.Amount, tt.wantAmount)
+			}
+			if rewards := repo.SavedRewards(); !reflect.DeepEqual(rewards, tt.wantRewards) && (0 < len(rewards) || 0 < len(tt.wantRewards)) {
+				t.Fatalf("rewards = %+v, want %+v", rewards, tt.wantRewards)
+			}
+
+			// 再跑一次不会重复分红或退款
+			if err := uuc.CardStatusHandle(context.Background()); nil != err {
+				t.Fatal(err)
+			}
+			if rewards := repo.SavedRewards(); len(rewards) != len(tt.wantRewards) {
+				t.Fatalf("rewards after rerun = %+v", rewards)
+			}
+			if u = repo.SavedUser(1); tt.wantAmount != u.Amount {
+				t.Fatalf("amount after rerun = %f, want %f", u.Amount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+// TestCardStatusHandlePendingToActive 发卡方审核中的卡下次查询激活后才写卡号并分红
+func TestCardStatusHandlePendingToActive(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newCardRepo()
+	card := fakerepo.NewCard()
+	card.Create[10001] = createCardOk("card-1")
+	card.Create[10002] = createCardOk("card-2")
+	card.Info["card-1"] = cardInfo("card-1", "PENDING", "")
+	card.Info["card-2"] = cardInfo("card-2", "PENDING", "")
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, nil, testLogger)
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if err := uuc.CardStatusHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(1); biz.UserCardOpening != c.Status || 0 < len(repo.SavedRewards()) {
+		t.Fatalf("card 1 = %s, rewards %+v, want opening without rewards", c.Status, repo.SavedRewards())
+	}
+
+	card.Info["card-1"] = cardInfo("card-1", "ACTIVE", "4111111111111111")
+	if err := uuc.CardStatusHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(1); biz.UserCardActive != c.Status || "4111111111111111" != repo.SavedUser(1).CardNumber {
+		t.Fatalf("card 1 = %s, user card number %s", c.Status, repo.SavedUser(1).CardNumber)
+	}
+	if c := repo.SavedCard(2); biz.UserCardOpening != c.Status {
+		t.Fatalf("card 2 = %s, want opening", c.Status)
+	}
+	if 2 != len(repo.SavedRewards()) {
+		t.Fatalf("rewards = %+v, want 2", repo.SavedRewards())
+	}
+}
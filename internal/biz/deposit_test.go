@@ -0,0 +1,111 @@
+package biz_test
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/fakerepo"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// depositChain 模拟节点，单次查询超过 maxRange 块时报错
+type depositChain struct {
+	biz.ChainProvider
+
+	confirmed uint64
+	maxRange  uint64
+	deposits  []*biz.ChainDeposit
+	queries   [][2]uint64
+}
+
+func (c *depositChain) DepositBlockRange() (uint64, uint64) {
+	return 1, 1000
+}
+
+func (c *depositChain) ConfirmedBlockNumber(ctx context.Context) (uint64, error) {
+	return c.confirmed, nil
+}
+
+func (c *depositChain) GetDeposits(ctx context.Context, fromBlock uint64, toBlock uint64) ([]*biz.ChainDeposit, error) {
+	c.queries = append(c.queries, [2]uint64{fromBlock, toBlock})
+	if toBlock-fromBlock+1 > c.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+
+	res := make([]*biz.ChainDeposit, 0)
+	for _, d := range c.deposits {
+		if fromBlock <= d.BlockNumber && d.BlockNumber <= toBlock {
+			res = append(res, d)
+		}
+	}
+	return res, nil
+}
+
+func usdt(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e18))
+}
+
+// TestDepositIndexHandle 节点拒绝大区间时减半重试，重扫不会重复入账和加业绩
+func TestDepositIndexHandle(t *testing.T) {
+	ctx := context.Background()
+
+	repo := fakerepo.New()
+	repo.AddUser(&biz.User{ID: 1, Address: "0xuser1"}, "D3D2")
+	repo.AddUser(&biz.User{ID: 2, Address: "0xuser2"}, "D3")
+	repo.AddUser(&biz.User{ID: 3, Address: "0xuser3"}, "")
+
+	chain := &depositChain{
+		confirmed: 1500,
+		maxRange:  300,
+		deposits: []*biz.ChainDeposit{
+			{TxHash: "0xa", LogIndex: 0, BlockNumber: 10, From: "0xuser1", Amount: usdt(100)},
+			{TxHash: "0xa", LogIndex: 1, BlockNumber: 10, From: "0xuser1", Amount: usdt(20)},
+			{TxHash: "0xb", LogIndex: 0, BlockNumber: 1200, From: "0xuser2", Amount: usdt(50)},
+			{TxHash: "0xc", LogIndex: 0, BlockNumber: 1300, From: "0xnobody", Amount: usdt(50)},
+		},
+	}
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, chain, testLogger)
+	if err := uuc.DepositIndexHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+		t.Fatal(err)
+	}
+
+	for _, q := range chain.queries {
+		if q[1]-q[0]+1 > 1000 {
+			t.Fatalf("query %v exceeds default block range", q)
+		}
+	}
+	if last := chain.queries[len(chain.queries)-1]; 1500 != last[1] {
+		t.Fatalf("last query %v, want scanned to 1500", last)
+	}
+	if 4 != len(repo.SavedDeposits()) {
+		t.Fatalf("deposits = %+v, want 4", repo.SavedDeposits())
+	}
+
+	check := func(when string) {
+		want := map[uint64][2]uint64{ // 余额，业绩
+			1: {120, 0},
+			2: {50, 120},
+			3: {0, 170},
+		}
+		for id, v := range want {
+			u := repo.SavedUser(id)
+			if fmt.Sprint(float64(v[0])) != fmt.Sprint(u.Amount) || v[1] != u.MyTotalAmount {
+				t.Fatalf("%s user %d amount %f total %d, want %d %d", when, id, u.Amount, u.MyTotalAmount, v[0], v[1])
+			}
+		}
+	}
+	check("first scan")
+
+	// 游标回退后重扫，已入账的跳过
+	if err := repo.SaveChainCursor(ctx, biz.ChainCursorDeposit, 0); nil != err {
+		t.Fatal(err)
+	}
+	if err := uuc.DepositIndexHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+		t.Fatal(err)
+	}
+	check("rescan")
+}
@@ -1,21 +1,18 @@
 package biz
 
 import (
-	"bytes"
 	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/secure"
 	"context"
 	"crypto/md5"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	jwt2 "github.com/golang-jwt/jwt/v5"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"net/url"
-	"sort"
+	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"sync"
@@ -29,38 +26,78 @@ type Admin struct {
 	Type     string
 }
 
+// AdminTypeSuper 超级管理员，可以查看完整卡号
+const AdminTypeSuper = "super"
+
+// CardRevealLog 查看完整卡号的审计记录
+type CardRevealLog struct {
+	ID        uint64
+	AdminId   uint64
+	UserId    uint64
+	CardId    string
+	Reason    string
+	Result    string // ok 成功，其余为拒绝原因
+	CreatedAt time.Time
+}
+
+// 持卡人状态
+const (
+	CardHolderNone      = "none"
+	CardHolderSubmitted = "submitted"
+	CardHolderPending   = "pending"
+	CardHolderActive    = "active"
+	CardHolderRejected  = "rejected"
+)
+
+// CardRecordType card_record 记录类型
+type CardRecordType uint64
+
+const (
+	CardRecordHolderFail      CardRecordType = 1  // 持卡人失败
+	CardRecordCardFail        CardRecordType = 2  // 开卡失败
+	CardRecordRechargeFail    CardRecordType = 3  // 充值失败
+	CardRecordHolderSuccess   CardRecordType = 4  // 持卡人通过
+	CardRecordCardSuccess     CardRecordType = 5  // 开卡成功
+	CardRecordRechargeSuccess CardRecordType = 6  // 充值成功
+	CardRecordFreeze          CardRecordType = 7  // 冻结
+	CardRecordUnfreeze        CardRecordType = 8  // 解冻
+	CardRecordCancel          CardRecordType = 9  // 销卡
+	CardRecordLimit           CardRecordType = 10 // 修改限额
+)
+
 type User struct {
-	ID            uint64
-	Address       string
-	Card          string
-	CardNumber    string
-	CardOrderId   string
-	CardAmount    float64
-	Amount        float64
-	AmountTwo     uint64
-	MyTotalAmount uint64
-	IsDelete      uint64
-	Vip           uint64
-	FirstName     string
-	LastName      string
-	BirthDate     string
-	Email         string
-	CountryCode   string
-	Phone         string
-	City          string
-	Country       string
-	Street        string
-	PostalCode    string
-	CardUserId    string
-	ProductId     string
-	MaxCardQuota  uint64
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	VipTwo        uint64
-	VipThree      uint64
-	CardTwo       uint64
-	CanVip        uint64
-	UserCount     uint64
+	ID             uint64
+	Address        string
+	Card           string
+	CardNumber     string
+	CardOrderId    string
+	CardAmount     float64
+	Amount         float64
+	AmountTwo      uint64
+	MyTotalAmount  uint64
+	IsDelete       uint64
+	Vip            uint64
+	FirstName      string
+	LastName       string
+	BirthDate      string
+	Email          string
+	CountryCode    string
+	Phone          string
+	City           string
+	Country        string
+	Street         string
+	PostalCode     string
+	CardUserId     string
+	CardUserStatus string
+	ProductId      string
+	MaxCardQuota   uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	VipTwo         uint64
+	VipThree       uint64
+	CardTwo        uint64
+	CanVip         uint64
+	UserCount      uint64
 }
 
 type UserRecommend struct {
@@ -79,16 +116,47 @@ type Config struct {
 }
 
 type Withdraw struct {
+	ID          uint64
+	UserId      uint64
+	Amount      float64
+	RelAmount   float64
+	Status      string
+	Address     string
+	TxHash      string
+	Nonce       uint64
+	RawTx       string
+	GasUsed     uint64
+	BlockNumber uint64
+	AdminId     uint64
+	Remark      string // 转人工原因或审核备注
+	BatchId     uint64 // 批量打款批次，0 为逐笔打款
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WithdrawBatch 批量打款批次，一批提现共用一笔交易，整批一起成功或失败
+type WithdrawBatch struct {
 	ID        uint64
-	UserId    uint64
+	TxHash    string
+	Nonce     uint64
+	Count     uint64
 	Amount    float64
-	RelAmount float64
-	Status    string
-	Address   string
+	Status    string // 同提现状态，sent success failed，放回待打款的为 approved
 	CreatedAt time.Time
-	UpdatedAt time.Time
 }
 
+// 提现状态
+const (
+	WithdrawRewarded      = "rewarded"       // 新提现，待风控
+	WithdrawPendingReview = "pending_review" // 未通过自动审核，待人工审核
+	WithdrawApproved      = "approved"       // 审核通过，待打款
+	WithdrawRejected      = "rejected"       // 驳回，已退回余额
+	WithdrawDoing         = "doing"          // 打款中，交易未广播
+	WithdrawSent          = "sent"           // 已广播，等待确认
+	WithdrawSuccess       = "success"        // 链上确认成功
+	WithdrawFailed        = "failed"         // 链上执行失败
+)
+
 type Reward struct {
 	ID        uint64
 	UserId    uint64
@@ -110,6 +178,298 @@ type EthUserRecord struct {
 	CreatedAt time.Time
 }
 
+// 链上扫描游标
+const (
+	ChainCursorDeposit = "deposit"
+)
+
+// 充值日志处理结果
+const (
+	EthDepositCredited = "credited"  // 已入账
+	EthDepositNoUser   = "no_user"   // 地址未注册
+	EthDepositTooSmall = "too_small" // 低于最小充值
+)
+
+// ChainDeposit 链上一笔充值转账
+type ChainDeposit struct {
+	TxHash      string
+	LogIndex    uint64
+	BlockNumber uint64
+	From        string
+	Amount      *big.Int // usdt 最小单位
+}
+
+// 提现交易类型
+const (
+	WithdrawTxSend    = "send"     // 首次发出
+	WithdrawTxSpeedUp = "speed_up" // 同 nonce 加价重发
+	WithdrawTxCancel  = "cancel"   // 同 nonce 转 0 给自己，取消原交易
+)
+
+// WithdrawTx 提现发出过的每一笔交易，同一个提现的交易 nonce 相同，最多只有一笔上链
+type WithdrawTx struct {
+	ID         uint64
+	WithdrawId uint64
+	BatchId    uint64 // 批量打款的交易记在批次上，WithdrawId 为 0
+	TxHash     string
+	Nonce      uint64
+	GasPrice   string // wei
+	Kind       string
+	RawTx      string
+	CreatedAt  time.Time
+}
+
+// ChainReceipt 交易回执
+type ChainReceipt struct {
+	Status      uint64 // 1 成功 0 失败
+	BlockNumber uint64
+	GasUsed     uint64
+}
+
+// ChainTxState 同一个节点上查到的热钱包 nonce 和提现交易回执
+type ChainTxState struct {
+	Confirmed uint64        // 节点的确认块高
+	Nonce     uint64        // 确认块高上热钱包已用掉的 nonce
+	TxHash    string        // 有回执的交易，都没有上链时为空
+	Receipt   *ChainReceipt // TxHash 的回执
+}
+
+// EthDeposit 充值日志记录，tx hash + log index 唯一
+type EthDeposit struct {
+	ID          uint64
+	TxHash      string
+	LogIndex    uint64
+	BlockNumber uint64
+	UserId      uint64
+	Address     string
+	Amount      string
+	AmountTwo   uint64 // 入账 usdt 整数
+	Status      string
+	CreatedAt   time.Time
+}
+
+type CardCallbackEvent struct {
+	ID        uint64
+	EventId   string
+	EventType string
+	EventName string
+	SourceId  string
+	Payload   string
+	Status    string
+	Times     uint64
+	Remark    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CardProductInfo struct {
+	ID            uint64
+	ProductId     string
+	ProductName   string
+	ModeType      string
+	CardBin       string
+	CardScheme    string
+	CardForm      string
+	CardCurrency  string
+	MaxCardQuota  uint64
+	ProductStatus string
+	Enable        uint64
+	UserCardMax   uint64 // 每个用户最多开卡数，0不限
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Usable 后台启用且发卡方可用
+func (p *CardProductInfo) Usable() bool {
+	return 1 == p.Enable && "ENABLED" == p.ProductStatus && 0 < p.MaxCardQuota
+}
+
+type CardRecharge struct {
+	ID            uint64
+	UserId        uint64
+	CardId        string
+	Amount        float64
+	ReferenceCode string
+	OrderId       string
+	Status        string
+	Remark        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type CardTransaction struct {
+	ID              uint64
+	UserId          uint64
+	CardId          string
+	TransactionId   string
+	TransactionType string
+	Status          string
+	Amount          float64
+	Currency        string
+	MerchantName    string
+	Mcc             string
+	TransactionTime time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type CardBalance struct {
+	ID        uint64
+	UserId    uint64
+	CardId    string
+	Balance   float64 // 发卡方余额
+	Expected  float64 // 本地计算余额，充值成功 + 退款 - 结算
+	Drift     uint64  // 1 对账不一致
+	CheckedAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// 用户卡片状态，只能按 userCardTransitions 流转
+const (
+	UserCardApplied    = "applied"    // 已申请，已扣开卡费
+	UserCardCreating   = "creating"   // 已向发卡方提交建卡，卡片id待落库，按 referenceCode 查询发卡方订单对账
+	UserCardOpening    = "opening"    // 发卡方已建卡，等待激活
+	UserCardActive     = "active"     // 已激活
+	UserCardFrozen     = "frozen"     // 已冻结
+	UserCardCancelling = "cancelling" // 已提交销卡，余额待退回
+	UserCardFailed     = "failed"     // 开卡失败，已退开卡费
+	UserCardCancelled  = "cancelled"  // 已销卡，卡内余额已退回
+)
+
+// UserCardCurrentStatus 未结束的卡片状态，计入开卡数量
+var UserCardCurrentStatus = []string{UserCardApplied, UserCardCreating, UserCardOpening, UserCardActive, UserCardFrozen, UserCardCancelling}
+
+var userCardTransitions = map[string][]string{
+	"":                 {UserCardApplied, UserCardOpening, UserCardActive}, // 新建，历史数据可以直接是开卡中或已激活
+	UserCardApplied:    {UserCardCreating, UserCardFailed},
+	UserCardCreating:   {UserCardOpening, UserCardFailed, UserCardApplied}, // 发卡方查无订单的退回已申请重新建卡
+	UserCardOpening:    {UserCardActive, UserCardFailed},
+	UserCardActive:     {UserCardFrozen, UserCardCancelling},
+	UserCardFrozen:     {UserCardActive, UserCardCancelling},
+	UserCardCancelling: {UserCardCancelled, UserCardActive, UserCardFrozen}, // 发卡方拒绝销卡的退回原状态
+}
+
+// checkUserCardTransition 校验卡片状态流转
+func checkUserCardTransition(from, to string) error {
+	for _, v := range userCardTransitions[from] {
+		if v == to {
+			return nil
+		}
+	}
+
+	return errors.New(500, "CARD_STATUS_ERROR", fmt.Sprintf("卡片状态不能从%s改为%s", from, to))
+}
+
+type UserCard struct {
+	ID          uint64
+	UserId      uint64
+	CardId      string
+	CardOrderId string
+	CardNumber  string
+	ProductId   string
+	Status      string
+	Main        uint64  // 1 和 user 表 card、card_number、card_order_id 同步的卡
+	Fee         float64 // 开卡费
+	FeeRewardId uint64  // 扣开卡费的 reward reason=3 记录
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// OpenReferenceCode 建卡请求的 referenceCode，每张卡固定，用来向发卡方查询建卡订单
+func (c *UserCard) OpenReferenceCode() string {
+	return fmt.Sprintf("OC%d", c.ID)
+}
+
+type UserCardHistory struct {
+	ID         uint64
+	UserCardId uint64
+	UserId     uint64
+	FromStatus string
+	ToStatus   string
+	Remark     string
+	CreatedAt  time.Time
+}
+
+// IssuerJournal 发卡方请求日志，请求和响应已脱敏，每次重试单独一条
+type IssuerJournal struct {
+	ID         uint64
+	Method     string
+	Path       string
+	CardId     string
+	Reference  string
+	Attempt    uint64
+	StatusCode uint64
+	Duration   uint64 // 毫秒
+	Request    string
+	Response   string
+	Error      string
+	CreatedAt  time.Time
+}
+
+// 实体卡订单状态，只能按顺序流转
+const (
+	CardTwoOrderSubmitted = "submitted"
+	CardTwoOrderApproved  = "approved"
+	CardTwoOrderProduced  = "produced"
+	CardTwoOrderShipped   = "shipped"
+	CardTwoOrderDelivered = "delivered"
+	CardTwoOrderActivated = "activated"
+)
+
+var cardTwoOrderStatus = []string{
+	CardTwoOrderSubmitted,
+	CardTwoOrderApproved,
+	CardTwoOrderProduced,
+	CardTwoOrderShipped,
+	CardTwoOrderDelivered,
+	CardTwoOrderActivated,
+}
+
+// cardTwoOrderStatusIndex 状态的顺序，未知状态返回-1
+func cardTwoOrderStatusIndex(status string) int {
+	for i, v := range cardTwoOrderStatus {
+		if v == status {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// cardTwoOrderStatusFrom status 及之后的状态
+func cardTwoOrderStatusFrom(status string) []string {
+	i := cardTwoOrderStatusIndex(status)
+	if 0 > i {
+		return []string{}
+	}
+
+	return cardTwoOrderStatus[i:]
+}
+
+type CardTwoOrder struct {
+	ID         uint64
+	UserId     uint64
+	RewardId   uint64 // 开实体卡记录 reward reason=9
+	Status     string
+	TrackingNo string
+	Receiver   string
+	Phone      string
+	Country    string
+	City       string
+	Street     string
+	PostalCode string
+	Rewarded   uint64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type CardProductDefault struct {
+	ID        uint64
+	VipTwo    uint64
+	ProductId string
+}
+
 type UserRepo interface {
 	SetNonceByAddress(ctx context.Context, wallet string) (int64, error)
 	GetAndDeleteWalletTimestamp(ctx context.Context, wallet string) (string, error)
@@ -128,6 +488,8 @@ type UserRepo interface {
 	GetAllUsers() ([]*User, error)
 	UpdateCard(ctx context.Context, userId uint64, cardOrderId, card string) error
 	UpdateCardNo(ctx context.Context, userId uint64, amount float64) error
+	UpdateCardUserSubmitted(ctx context.Context, userId uint64, productId string, maxCardQuota uint64, referenceCode string) error
+	UpdateCardUserStatus(ctx context.Context, userId uint64, from []string, status string, cardUserId string) error
 	UpdateCardSucces(ctx context.Context, userId uint64, cardNum string) error
 	CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
 	CreateCardRecommendTwo(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
@@ -136,15 +498,32 @@ type UserRepo interface {
 	Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error
 	GetUserRewardByUserIdPage(ctx context.Context, b *Pagination, userId uint64, reason uint64) ([]*Reward, error, int64)
 	SetVip(ctx context.Context, userId uint64, vip uint64) error
-	GetUsersOpenCard() ([]*User, error)
-	GetUsersOpenCardStatusDoing() ([]*User, error)
-	GetEthUserRecordLast() (int64, error)
 	GetUserByAddresses(Addresses ...string) (map[string]*User, error)
 	GetUserRecommends() ([]*UserRecommend, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
 	UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error
-	UpdateWithdraw(ctx context.Context, id uint64, status string) (*Withdraw, error)
-	InsertCardRecord(ctx context.Context, userId, recordType uint64, remark string, code string, opt string) error
+	UpdateWithdraw(ctx context.Context, id uint64, from []string, status string) (*Withdraw, error)
+	UpdateWithdrawSent(ctx context.Context, id uint64, claimedAt time.Time, txHash string, nonce uint64, rawTx string) error
+	UpdateWithdrawDoingTimeout(ctx context.Context, before time.Time) (int64, error)
+	UpdateWithdrawReplaced(ctx context.Context, id uint64, txHash string, rawTx string) error
+	UpdateWithdrawConfirmed(ctx context.Context, id uint64, status string, txHash string, gasUsed uint64, blockNumber uint64) error
+	UpdateWithdrawRequeue(ctx context.Context, id uint64, from string) error
+	GetWithdrawsByStatus(status string, limit int) ([]*Withdraw, error)
+	GetWithdrawById(id uint64) (*Withdraw, error)
+	GetWithdraws(b *Pagination, userId uint64, status string) ([]*Withdraw, error, int64)
+	GetWithdrawAmountSum(userId uint64, startTime time.Time, exceptId uint64) (float64, error)
+	UpdateWithdrawReview(ctx context.Context, id uint64, from []string, status string, adminId uint64, remark string) error
+	RejectWithdraw(ctx context.Context, w *Withdraw, from []string, adminId uint64, remark string) error
+	CreateWithdrawTx(ctx context.Context, wt *WithdrawTx) error
+	GetWithdrawTxs(withdrawId uint64, batchId uint64) ([]*WithdrawTx, error)
+	CreateWithdrawBatch(ctx context.Context, b *WithdrawBatch) (uint64, error)
+	UpdateWithdrawBatchSent(ctx context.Context, ids []uint64, batchId uint64, txHash string, nonce uint64, rawTx string) error
+	UpdateWithdrawBatchReplaced(ctx context.Context, batchId uint64, txHash string, rawTx string) error
+	UpdateWithdrawBatchConfirmed(ctx context.Context, batchId uint64, status string, txHash string, gasUsed uint64, blockNumber uint64) error
+	UpdateWithdrawBatchRequeue(ctx context.Context, batchId uint64) error
+	GetWalletNonce(ctx context.Context, address string) (uint64, error)
+	SaveWalletNonce(ctx context.Context, address string, nonce uint64) error
+	InsertCardRecord(ctx context.Context, userId uint64, recordType CardRecordType, remark string, code string, opt string) error
 	UpdateCardTwo(ctx context.Context, id uint64) error
 	GetUserCardTwo() ([]*Reward, error)
 	GetUsers(b *Pagination, address string) ([]*User, error, int64)
@@ -154,19 +533,112 @@ type UserRepo interface {
 	SetUserCount(ctx context.Context, userId uint64) (bool, error)
 	GetConfigs() ([]*Config, error)
 	UpdateConfig(ctx context.Context, id int64, value string) (bool, error)
+	CreateCardCallbackEvent(ctx context.Context, e *CardCallbackEvent) (bool, error)
+	GetCardCallbackEventsPending(maxTimes uint64, limit int) ([]*CardCallbackEvent, error)
+	UpdateCardCallbackEvent(ctx context.Context, id uint64, status string, remark string) error
+	GetCardCallbackEvents(b *Pagination, eventId string, status string) ([]*CardCallbackEvent, error, int64)
+	SaveCardProduct(ctx context.Context, p *CardProductInfo) error
+	GetCardProducts() ([]*CardProductInfo, error)
+	GetCardProductByProductId(productId string) (*CardProductInfo, error)
+	SetCardProductEnable(ctx context.Context, productId string, enable uint64) error
+	GetCardProductDefaults() ([]*CardProductDefault, error)
+	GetCardProductDefault(vipTwo uint64) (*CardProductInfo, error)
+	SetCardProductDefault(ctx context.Context, vipTwo uint64, productId string) error
+	CreateCardRecharge(ctx context.Context, r *CardRecharge) error
+	GetCardRechargesPending(limit int) ([]*CardRecharge, error)
+	GetCardRechargeByReferenceCode(referenceCode string) (*CardRecharge, error)
+	UpdateCardRecharge(ctx context.Context, id uint64, from []string, status string, orderId string, remark string) error
+	CardRechargeBack(ctx context.Context, r *CardRecharge) error
+	GetCardRecharges(b *Pagination, userId uint64, status string) ([]*CardRecharge, error, int64)
+	CancelCard(ctx context.Context, userId uint64, cardId string, balance float64) error
+	SaveCardTransaction(ctx context.Context, t *CardTransaction) error
+	GetCardTransactionLastTime() (time.Time, error)
+	GetCardTransactions(b *Pagination, userId uint64, cardId string, status string, startTime time.Time, endTime time.Time) ([]*CardTransaction, error, int64)
+	GetCardRechargeSum(cardId string) (float64, error)
+	GetCardTransactionSum(cardId string, transactionType string, status string) (float64, error)
+	SaveCardBalance(ctx context.Context, b *CardBalance) error
+	GetCardBalancesDrift(b *Pagination, userId uint64) ([]*CardBalance, error, int64)
+	CreateCardTwoOrder(ctx context.Context, o *CardTwoOrder) error
+	GetCardTwoOrderById(id uint64) (*CardTwoOrder, error)
+	GetCardTwoOrdersUnRewarded(status []string) ([]*CardTwoOrder, error)
+	UpdateCardTwoOrderStatus(ctx context.Context, id uint64, from string, status string, trackingNo string) error
+	UpdateCardTwoOrderAddress(ctx context.Context, o *CardTwoOrder, status []string) error
+	UpdateCardTwoOrderRewarded(ctx context.Context, id uint64) error
+	CreateUserCard(ctx context.Context, c *UserCard, remark string) error
+	UpdateUserCardStatus(ctx context.Context, c *UserCard, status string, remark string) error
+	GetUserCardsByStatus(status ...string) ([]*UserCard, error)
+	GetUserCardByCardId(cardId string) (*UserCard, error)
+	GetUserCardCurrent(userId uint64) (*UserCard, error)
+	GetUserCardsByUserId(userId uint64, status ...string) ([]*UserCard, error)
+	GetUserCardCounts(userIds ...uint64) (map[uint64]int64, error)
+	GetUserCards(b *Pagination, userId uint64, status string) ([]*UserCard, error, int64)
+	CreateUserCardApply(ctx context.Context, c *UserCard, amount float64) error
+	UpdateCardOpenBack(ctx context.Context, userId uint64, amount float64) error
+	GetUserRewardCardFee(userId uint64) (*Reward, error)
+	SetCardProductUserCardMax(ctx context.Context, productId string, userCardMax uint64) error
+	GetUsersWithoutUserCard() ([]*User, error)
+	GetUserCardHistories(b *Pagination, userId uint64, userCardId uint64) ([]*UserCardHistory, error, int64)
+	GetCardTwoOrders(b *Pagination, userId uint64, status string) ([]*CardTwoOrder, error, int64)
+	CreateIssuerJournal(ctx context.Context, j *IssuerJournal) error
+	GetAdminById(ctx context.Context, id uint64) (*Admin, error)
+	CreateCardRevealLog(ctx context.Context, l *CardRevealLog) error
+	GetCardRevealLogs(b *Pagination, userId uint64, cardId string) ([]*CardRevealLog, error, int64)
+	EncryptSensitiveFields(ctx context.Context) (int64, error)
+	GetChainCursor(name string) (uint64, error)
+	SaveChainCursor(ctx context.Context, name string, block uint64) error
+	CreateEthDeposit(ctx context.Context, d *EthDeposit) (bool, error)
+	GetIssuerJournals(b *Pagination, path string, cardId string, reference string, failed bool, startTime time.Time, endTime time.Time) ([]*IssuerJournal, error, int64)
+}
+
+// ChainProvider 链上数据接口
+type ChainProvider interface {
+	ConfirmedBlockNumber(ctx context.Context) (uint64, error)
+	DepositBlockRange() (uint64, uint64)
+	GetDeposits(ctx context.Context, fromBlock uint64, toBlock uint64) ([]*ChainDeposit, error)
+	WithdrawTxState(ctx context.Context, txHashes []string) (*ChainTxState, error)
+	WalletAddress() string
+	WalletNonce(ctx context.Context) (uint64, error)
+	SendRawTx(ctx context.Context, rawTx string) error
+	ReplaceTx(ctx context.Context, rawTx string, cancel bool) (*WithdrawTx, error)
+	ReplaceAfter() time.Duration
+}
+
+// CardProvider 发卡方接口，ispay 等发卡渠道各自实现
+type CardProvider interface {
+	CreateCard(ctx context.Context, cardAmount uint64, cardholderId uint64, cardProductId uint64, referenceCode string) (*CreateCardResponse, error)
+	QueryCardOrder(ctx context.Context, referenceCode string) (*CreateCardResponse, error)
+	GetCardInfo(ctx context.Context, cardId string) (*CardInfoResponse, error)
+	QueryCardHolder(ctx context.Context, holderId uint64, productId uint64) (*QueryCardHolderResponse, error)
+	CreateCardholder(ctx context.Context, productId uint64, user *User, referenceCode string) (*CreateCardholderResponse, error)
+	QueryCardHolderOrder(ctx context.Context, referenceCode string) (*CreateCardholderResponse, error)
+	GetCardProducts(ctx context.Context) (*CardProductListResponse, error)
+	RechargeCard(ctx context.Context, cardId string, amount float64, referenceCode string) (*RechargeCardResponse, error)
+	FreezeCard(ctx context.Context, cardId string) (*CardOperateResponse, error)
+	UnfreezeCard(ctx context.Context, cardId string) (*CardOperateResponse, error)
+	CancelCard(ctx context.Context, cardId string) (*CardOperateResponse, error)
+	UpdateCardLimit(ctx context.Context, cardId string, dailyLimit uint64, monthlyLimit uint64) (*CardOperateResponse, error)
+	GetCardTransactions(ctx context.Context, startTime string, endTime string, pageNum int, pageSize int) (*CardTransactionListResponse, error)
 }
 
 type UserUseCase struct {
-	repo UserRepo
-	tx   Transaction
-	log  *log.Helper
+	repo  UserRepo
+	tx    Transaction
+	card  CardProvider
+	chain ChainProvider
+	log   *log.Helper
+
+	// 确认任务第一次看到提现交易被丢弃的时间，key 为批次id和提现id，持有 withdrawConfirmLockHandle 时读写
+	withdrawDropped map[[2]uint64]time.Time
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, card CardProvider, chain ChainProvider, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
-		repo: repo,
-		tx:   tx,
-		log:  log.NewHelper(logger),
+		repo:            repo,
+		tx:              tx,
+		card:            card,
+		chain:           chain,
+		log:             log.NewHelper(logger),
+		withdrawDropped: make(map[[2]uint64]time.Time, 0),
 	}
 }
 
@@ -177,50 +649,164 @@ type Pagination struct {
 
 // 后台
 
-func (uuc *UserUseCase) GetEthUserRecordLast() (int64, error) {
-	return uuc.repo.GetEthUserRecordLast()
-}
 func (uuc *UserUseCase) GetUserByAddress(Addresses ...string) (map[string]*User, error) {
 	return uuc.repo.GetUserByAddresses(Addresses...)
 }
 
-func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, amount uint64, eth *EthUserRecord, system bool) error {
-	// 推荐人
+var depositLockHandle sync.Mutex
+
+// DepositIndexHandle 按块区间读取已确认的充值转账日志，游标持久化，每笔按 tx hash + log index 只入账一次
+func (uuc *UserUseCase) DepositIndexHandle(ctx context.Context, end time.Time) error {
+	depositLockHandle.Lock()
+	defer depositLockHandle.Unlock()
+
 	var (
-		err error
+		cursor    uint64
+		confirmed uint64
+		deposits  []*ChainDeposit
+		err       error
 	)
 
-	// 入金
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		// 充值记录
-		if !system {
-			_, err = uuc.repo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:      eth.Hash,
-				UserId:    eth.UserId,
-				Amount:    eth.Amount,
-				AmountTwo: amount,
-				Last:      eth.Last,
-			})
+	startBlock, blockRange := uuc.chain.DepositBlockRange()
+	cursor, err = uuc.repo.GetChainCursor(ChainCursorDeposit)
+	if nil != err {
+		return err
+	}
+
+	from := cursor + 1
+	if 0 == cursor {
+		if 0 == startBlock {
+			return errors.New(500, "CHAIN_CONFIG_ERROR", "未配置充值扫描起始块")
+		}
+		from = startBlock
+	}
+
+	confirmed, err = uuc.chain.ConfirmedBlockNumber(ctx)
+	if nil != err {
+		return err
+	}
+
+	for from <= confirmed && time.Now().UTC().Before(end) {
+		to := from + blockRange - 1
+		if to > confirmed {
+			to = confirmed
+		}
+
+		deposits, err = uuc.chain.GetDeposits(ctx, from, to)
+		if nil != err {
+			// 节点限制单次查询的块数或日志条数，区间减半重试，本次任务后面都按缩小后的区间扫
+			if from < to {
+				fmt.Println("充值日志查询失败，缩小区间重试", from, to, err)
+				blockRange = (to - from + 1) / 2
+				continue
+			}
+			return err
+		}
+
+		// 中途失败不推进游标，下次重扫已入账的会跳过
+		for _, d := range deposits {
+			err = uuc.deposit(ctx, d)
 			if nil != err {
 				return err
 			}
 		}
 
-		return nil
+		err = uuc.repo.SaveChainCursor(ctx, ChainCursorDeposit, to)
+		if nil != err {
+			return err
+		}
+
+		from = to + 1
+	}
+
+	return nil
+}
+
+// deposit 充值记录和入账在同一个事务，记录已存在说明处理过
+func (uuc *UserUseCase) deposit(ctx context.Context, d *ChainDeposit) error {
+	var (
+		users    map[string]*User
+		user     *User
+		credited bool
+		err      error
+	)
+
+	amount := new(big.Int).Div(d.Amount, big.NewInt(1e18)).Uint64()
+	ethDeposit := &EthDeposit{
+		TxHash:      d.TxHash,
+		LogIndex:    d.LogIndex,
+		BlockNumber: d.BlockNumber,
+		Address:     d.From,
+		Amount:      d.Amount.String(),
+		AmountTwo:   amount,
+		Status:      EthDepositCredited,
+	}
+
+	users, err = uuc.repo.GetUserByAddresses(d.From)
+	if nil != err {
+		return err
+	}
+	if _, ok := users[d.From]; ok {
+		user = users[d.From]
+		ethDeposit.UserId = user.ID
+	}
+
+	if nil == user {
+		ethDeposit.Status = EthDepositNoUser
+	} else if 10 > amount {
+		ethDeposit.Status = EthDepositTooSmall
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		created, err := uuc.repo.CreateEthDeposit(ctx, ethDeposit)
+		if nil != err {
+			return err
+		}
+		if !created || EthDepositCredited != ethDeposit.Status {
+			return nil
+		}
+
+		_, err = uuc.repo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+			Hash:      d.TxHash,
+			UserId:    int64(user.ID),
+			Amount:    d.Amount.String(),
+			AmountTwo: amount,
+			Last:      int64(d.BlockNumber),
+		})
+		if nil != err {
+			return err
+		}
+
+		credited = true
+		// 业绩和入账一起提交，重扫时已入账的不会重复加业绩
+		return uuc.addRecommendTotalAmount(ctx, user.ID, amount)
 	}); nil != err {
-		fmt.Println(err, "错误投资3", userId, amount)
+		fmt.Println(err, "充值入账错误", d.TxHash, d.LogIndex, d.From, amount)
 		return err
 	}
 
+	if !credited && EthDepositCredited != ethDeposit.Status {
+		fmt.Println("充值未入账", ethDeposit.Status, d.TxHash, d.LogIndex, d.From, d.Amount.String())
+	}
+
+	return nil
+}
+
+// addRecommendTotalAmount 给上级增加业绩，在充值入账事务内调用，单个上级失败只回滚这一条
+func (uuc *UserUseCase) addRecommendTotalAmount(ctx context.Context, userId uint64, amount uint64) error {
 	// 推荐人
 	var (
 		userRecommend       *UserRecommend
 		tmpRecommendUserIds []string
+		err                 error
 	)
 	userRecommend, err = uuc.repo.GetUserRecommendByUserId(userId)
 	if nil != err {
 		return err
 	}
+	if nil == userRecommend {
+		return nil
+	}
 	if "" != userRecommend.RecommendCode {
 		tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
 	}
@@ -241,7 +827,7 @@ func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, amount ui
 
 			return nil
 		}); nil != err {
-			fmt.Println("遍历业绩：", err, tmpUserId, eth)
+			fmt.Println("遍历业绩：", err, tmpUserId, userId)
 			continue
 		}
 	}
@@ -256,597 +842,2870 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 	defer lockHandle.Unlock()
 
 	var (
-		userOpenCard []*User
-		err          error
+		cards []*UserCard
+		err   error
 	)
 
-	userOpenCard, err = uuc.repo.GetUsersOpenCard()
+	err = uuc.syncUserCards(ctx)
 	if nil != err {
 		return err
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	cards, err = uuc.repo.GetUserCardsByStatus(UserCardCreating, UserCardApplied)
+	if nil != err {
+		return err
 	}
 
-	//var (
-	//	products          *CardProductListResponse
-	//	productIdUse      string
-	//	productIdUseInt64 uint64
-	//	maxCardQuota      int
-	//)
-	//products, err = GetCardProducts()
-	//if nil == products || nil != err {
-	//	fmt.Println("产品信息错误1")
-	//	return nil
-	//}
-	//
-	//for _, v := range products.Rows {
-	//	if 0 < len(v.ProductId) && "ENABLED" == v.ProductStatus {
-	//		productIdUse = v.ProductId
-	//		maxCardQuota = v.MaxCardQuota
-	//		productIdUseInt64, err = strconv.ParseUint(productIdUse, 10, 64)
-	//		if nil != err {
-	//			fmt.Println("产品信息错误2")
-	//			return nil
-	//		}
-	//		fmt.Println("当前选择产品信息", productIdUse, maxCardQuota, v)
-	//		break
-	//	}
-	//}
-	//
-	//if 0 >= maxCardQuota {
-	//	fmt.Println("产品信息错误3")
-	//	return nil
-	//}
-	//
-	//if 0 >= productIdUseInt64 {
-	//	fmt.Println("产品信息错误4")
-	//	return nil
-	//}
-
-	for _, user := range userOpenCard {
-		//var (
-		//	resCreatCardholder *CreateCardholderResponse
-		//)
-		//resCreatCardholder, err = CreateCardholderRequest(productIdUseInt64, user)
-		//if nil == resCreatCardholder || 200 != resCreatCardholder.Code || err != nil {
-		//	fmt.Println("持卡人订单创建失败", user, resCreatCardholder, err)
-		//	continue
-		//}
-		//if 0 > len(resCreatCardholder.Data.HolderID) {
-		//	fmt.Println("持卡人订单信息错误", user, resCreatCardholder, err)
-		//	continue
-		//}
-		//fmt.Println("持卡人信息", user, resCreatCardholder)
-		//
-
+	for _, card := range cards {
 		var (
-			holderId          uint64
-			productIdUseInt64 uint64
-			resCreatCard      *CreateCardResponse
-			openRes           = true
+			user *User
 		)
-		if 5 > len(user.CardUserId) {
-			fmt.Println("持卡人id空", user)
-			openRes = false
-		}
-		holderId, err = strconv.ParseUint(user.CardUserId, 10, 64)
-		if nil != err {
-			fmt.Println("持卡人错误2")
-			openRes = false
-		}
-		if 0 >= holderId {
-			fmt.Println("持卡人错误3")
-			openRes = false
-		}
-		if 5 > len(user.CardUserId) {
-			fmt.Println("持卡人id空", user)
-			openRes = false
-		}
-
-		if 0 >= user.MaxCardQuota {
-			fmt.Println("最大额度错误", user)
-			openRes = false
-		}
-
-		if 5 > len(user.ProductId) {
-			fmt.Println("productid空", user)
-			openRes = false
-		}
-		productIdUseInt64, err = strconv.ParseUint(user.ProductId, 10, 64)
-		if nil != err {
-			fmt.Println("产品信息错误1")
-			openRes = false
-		}
-		if 0 >= productIdUseInt64 {
-			fmt.Println("产品信息错误2")
-			openRes = false
+		user, err = uuc.repo.GetUserById(card.UserId)
+		if nil == user {
+			fmt.Println("开卡，用户不存在", card.ID, card.UserId, err)
+			continue
 		}
 
-		if !openRes {
-			fmt.Println("回滚了用户", user)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+		// 已提交过建卡的只查询发卡方订单，不重复建卡
+		if UserCardCreating == card.Status {
+			if err = uuc.openCardCheck(ctx, user, card); nil != err {
+				fmt.Println("建卡订单对账失败", card.ID, card.UserId, err)
 			}
-
 			continue
 		}
 
-		//
-		var (
-			resHolder *QueryCardHolderResponse
-		)
-
-		resHolder, err = QueryCardHolderWithSign(holderId, productIdUseInt64)
-		if nil == resHolder || err != nil || 200 != resHolder.Code {
-			fmt.Println(user, err, "持卡人信息请求错误", resHolder)
+		// 单张卡失败不影响后面的卡，下次任务重试
+		if err = uuc.openCard(ctx, user, card); nil != err {
+			fmt.Println("开卡失败", card.ID, card.UserId, err)
 			continue
 		}
+	}
 
-		if "active" == resHolder.Data.Status {
+	return nil
+}
 
-		} else if "pending" == resHolder.Data.Status {
-			continue
-		} else {
-			fmt.Println(user, err, "持卡人创建失败", resHolder)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
-			}
-			continue
-		}
+// syncUserCards 用户端申请开卡只修改 user.card_order_id，没有卡片记录的在这里补建，历史数据同样按 user 表字段补建
+func (uuc *UserUseCase) syncUserCards(ctx context.Context) error {
+	var (
+		users []*User
+		err   error
+	)
 
-		resCreatCard, err = CreateCardRequestWithSign(0, holderId, productIdUseInt64)
-		if nil == resCreatCard || 200 != resCreatCard.Code || err != nil {
-			fmt.Println("开卡订单创建失败", user, resCreatCard, err)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+	users, err = uuc.repo.GetUsersWithoutUserCard()
+	if nil != err {
+		return err
+	}
+
+	for _, user := range users {
+		card := &UserCard{
+			UserId:    user.ID,
+			ProductId: user.ProductId,
+			Status:    UserCardApplied,
+			Main:      1,
+		}
+		if "do" != user.CardOrderId {
+			card.CardId = user.Card
+			card.CardOrderId = user.CardOrderId
+			card.Status = UserCardOpening
+			if "no" != user.CardNumber {
+				card.CardNumber = user.CardNumber
+				card.Status = UserCardActive
 			}
-			continue
 		}
-		fmt.Println("开卡信息：", user, resCreatCard)
 
-		if 0 >= len(resCreatCard.Data.CardID) || 0 >= len(resCreatCard.Data.CardOrderID) {
-			fmt.Println("开卡订单信息错误", resCreatCard, err)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
-			}
-			continue
+		// 用户端开卡时扣费写的 reason=3 记录，退款按这条的金额退
+		var (
+			feeReward *Reward
+		)
+		feeReward, err = uuc.repo.GetUserRewardCardFee(user.ID)
+		if nil != err {
+			return err
+		}
+		if nil != feeReward {
+			card.Fee = feeReward.Amount
+			card.FeeRewardId = feeReward.ID
 		}
 
 		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCard(ctx, user.ID, resCreatCard.Data.CardOrderID, resCreatCard.Data.CardID)
-			if nil != err {
-				return err
-			}
-
-			return nil
+			return uuc.repo.CreateUserCard(ctx, card, "sync")
 		}); nil != err {
-			fmt.Println(err, "开卡后，写入mysql错误", err, user, resCreatCard)
-			return nil
+			fmt.Println("卡片记录创建失败", user.ID, err)
 		}
 	}
 
 	return nil
 }
 
-var cardStatusLockHandle sync.Mutex
-
-func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
-	cardStatusLockHandle.Lock()
-	defer cardStatusLockHandle.Unlock()
-
+// cardFee 开卡费，按产品配置 card_fee_product_{productId}，没有的按区域配置 card_fee_{vipTwo}，都没有的老区10新区30
+func (uuc *UserUseCase) cardFee(user *User, productId string) float64 {
 	var (
-		userOpenCard []*User
-		err          error
+		configs    []*Config
+		productKey = "card_fee_product_" + productId
+		zoneKey    = fmt.Sprintf("card_fee_%d", user.VipTwo)
+		productFee float64
+		zoneFee    float64
 	)
 
-	userOpenCard, err = uuc.repo.GetUsersOpenCardStatusDoing()
-	if nil != err {
-		return err
+	fee := float64(10)
+	if 0 < user.VipTwo {
+		fee = float64(30)
 	}
 
-	var (
-		users    []*User
-		usersMap map[uint64]*User
-	)
-	users, err = uuc.repo.GetAllUsers()
-	if nil == users {
-		fmt.Println("用户无")
-		return nil
+	configs, _ = uuc.repo.GetConfigByKeys(productKey, zoneKey)
+	for _, vConfig := range configs {
+		if productKey == vConfig.KeyName && "" != productId {
+			productFee, _ = strconv.ParseFloat(vConfig.Value, 64)
+		}
+		if zoneKey == vConfig.KeyName {
+			zoneFee, _ = strconv.ParseFloat(vConfig.Value, 64)
+		}
 	}
 
-	usersMap = make(map[uint64]*User, 0)
-	for _, vUsers := range users {
-		usersMap[vUsers.ID] = vUsers
+	if 0 < productFee {
+		return productFee
+	}
+	if 0 < zoneFee {
+		return zoneFee
+	}
+
+	return fee
+}
+
+// cardRefundAmount 开卡失败退款，退扣费记录的金额
+// 没有关联扣费记录的历史卡片查用户未关联卡片的扣费记录，仍找不到的按原来固定的老区10新区30，不按当前配置
+func (uuc *UserUseCase) cardRefundAmount(card *UserCard, user *User) float64 {
+	if 0 < card.FeeRewardId {
+		return card.Fee
+	}
+
+	feeReward, err := uuc.repo.GetUserRewardCardFee(user.ID)
+	if nil != err {
+		fmt.Println("开卡扣费记录查询失败", user.ID, card.ID, err)
+	}
+	if nil != feeReward && 0 < feeReward.Amount {
+		return feeReward.Amount
+	}
+
+	backAmount := float64(10)
+	if 0 < user.VipTwo {
+		backAmount = float64(30)
+	}
+	return backAmount
+}
+
+// userCardTransition 校验后修改卡片状态并记录历史，调用方在同一事务内修改 user 表兼容字段
+func (uuc *UserUseCase) userCardTransition(ctx context.Context, card *UserCard, status string, remark string) error {
+	if err := checkUserCardTransition(card.Status, status); nil != err {
+		return err
+	}
+
+	return uuc.repo.UpdateUserCardStatus(ctx, card, status, remark)
+}
+
+// openCard 单个用户开卡，调用方持有 lockHandle
+// 持卡人 none → submitted → pending → active / rejected，active 后才开卡
+func (uuc *UserUseCase) openCard(ctx context.Context, user *User, card *UserCard) error {
+	var (
+		holderId          uint64
+		product           *CardProductInfo
+		productIdUseInt64 uint64
+		resCreatCard      *CreateCardResponse
+		err               error
+	)
+
+	status := user.CardUserStatus
+	// 历史数据：已有持卡人id的按审核中处理
+	if CardHolderNone == status && 5 <= len(user.CardUserId) {
+		status = CardHolderPending
+	}
+
+	switch status {
+	case CardHolderNone, CardHolderSubmitted, CardHolderRejected:
+		return uuc.submitCardHolder(ctx, user)
+	case CardHolderPending, CardHolderActive:
+	default:
+		fmt.Println("持卡人状态错误", user.ID, user.CardUserStatus)
+		return nil
+	}
+
+	holderId, err = strconv.ParseUint(user.CardUserId, 10, 64)
+	if nil != err || 0 >= holderId {
+		fmt.Println("持卡人错误", user.ID, err)
+		return uuc.rejectCardHolder(ctx, user.ID, "持卡人id错误")
 	}
 
-	if 0 >= len(userOpenCard) {
+	// 卡片指定了产品的按卡片产品开，否则按持卡人提交时的产品，产品以目录为准
+	productId := user.ProductId
+	if "" != card.ProductId {
+		productId = card.ProductId
+	}
+	product, err = uuc.repo.GetCardProductByProductId(productId)
+	if nil == product {
+		fmt.Println("产品不在目录中", user.ID, err)
 		return nil
 	}
+	productIdUseInt64, err = strconv.ParseUint(product.ProductId, 10, 64)
+	if nil != err || 0 >= productIdUseInt64 || !product.Usable() {
+		fmt.Println("产品信息错误", user.ID, product, err)
+		return uuc.rejectCardHolder(ctx, user.ID, "产品信息错误")
+	}
 
-	for _, user := range userOpenCard {
-		// 查询状态。成功分红
+	if CardHolderPending == status {
 		var (
-			resCard *CardInfoResponse
+			resHolder *QueryCardHolderResponse
 		)
-		if 2 >= len(user.Card) {
-			continue
+
+		resHolder, err = uuc.card.QueryCardHolder(ctx, holderId, productIdUseInt64)
+		if nil == resHolder || err != nil || 200 != resHolder.Code {
+			fmt.Println(user.ID, err, "持卡人信息请求错误")
+			return nil
 		}
 
-		resCard, err = GetCardInfoRequestWithSign(user.Card)
-		if nil == resCard || 200 != resCard.Code || err != nil {
-			fmt.Println(resCard, err)
-			continue
+		if CardHolderPending == resHolder.Data.Status {
+			return nil
+		} else if CardHolderActive != resHolder.Data.Status {
+			fmt.Println(user.ID, "持卡人创建失败", resHolder.Data.Status, resHolder.Msg)
+			return uuc.rejectCardHolder(ctx, user.ID, resHolder.Msg)
 		}
 
-		if "ACTIVE" == resCard.Data.CardStatus {
-			fmt.Println("开卡状态，激活：", resCard, user.ID)
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.UpdateCardSucces(ctx, user.ID, resCard.Data.Pan)
-				if err != nil {
-					return err
-				}
+		err = uuc.repo.UpdateCardUserStatus(ctx, user.ID, []string{CardHolderNone, CardHolderPending}, CardHolderActive, "")
+		if nil != err {
+			fmt.Println("持卡人状态修改失败", user.ID, err)
+			return nil
+		}
+	}
 
-				return nil
-			}); nil != err {
-				fmt.Println("err，开卡成功", err, user.ID)
-				continue
-			}
-		} else if "PENDING" == resCard.Data.CardStatus || "PROGRESS" == resCard.Data.CardStatus {
-			fmt.Println("开卡状态，待处理：", resCard, user.ID)
-			continue
-		} else {
-			fmt.Println("开卡状态，失败：", resCard, user.ID)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
-			}
-			continue
+	// 先记建卡中再请求发卡方，落库失败时下次按 referenceCode 对账，不会重复建卡
+	card.ProductId = product.ProductId
+	if err = uuc.userCardTransition(ctx, card, UserCardCreating, ""); nil != err {
+		return err
+	}
+	card.Status = UserCardCreating
+
+	resCreatCard, err = uuc.card.CreateCard(ctx, 0, holderId, productIdUseInt64, card.OpenReferenceCode())
+	if nil != err && errors.Is(err, ErrIssuerNotSent) {
+		fmt.Println("开卡请求未发出", user.ID, card.ID, err)
+		return uuc.userCardTransition(ctx, card, UserCardApplied, "发卡方请求未发出")
+	}
+	if nil != err && !errors.Is(err, ErrIssuerRejected) {
+		// 发卡方可能已建卡，停在建卡中，下次按 referenceCode 对账
+		fmt.Println("开卡结果未知，等待对账", user.ID, card.ID, err)
+		return nil
+	}
+	if nil == resCreatCard || 200 != resCreatCard.Code || err != nil {
+		fmt.Println("开卡订单创建失败", user.ID, card.ID, err)
+		backAmount := uuc.cardRefundAmount(card, user)
+		err = uuc.backCard(ctx, card, backAmount, "开卡订单创建失败")
+		if nil != err {
+			fmt.Println("回滚了用户失败", user.ID, err)
 		}
+		return nil
+	}
+	fmt.Println("开卡信息：", user.ID, card.ID, resCreatCard.Data.CardID, resCreatCard.Data.CardOrderID)
 
-		// 分红
+	if 0 >= len(resCreatCard.Data.CardID) || 0 >= len(resCreatCard.Data.CardOrderID) {
+		fmt.Println("开卡订单信息错误", resCreatCard, err)
+		backAmount := uuc.cardRefundAmount(card, user)
+		err = uuc.backCard(ctx, card, backAmount, "开卡订单信息错误")
+		if nil != err {
+			fmt.Println("回滚了用户失败", user.ID, err)
+		}
+		return nil
+	}
+
+	return uuc.openCardSave(ctx, user, card, resCreatCard)
+}
+
+// openCardSave 发卡方返回的卡片id和订单id落库，建卡中改为开卡中
+func (uuc *UserUseCase) openCardSave(ctx context.Context, user *User, card *UserCard, resCreatCard *CreateCardResponse) error {
+	var (
+		err error
+	)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		card.CardId = resCreatCard.Data.CardID
+		card.CardOrderId = resCreatCard.Data.CardOrderID
+		err = uuc.userCardTransition(ctx, card, UserCardOpening, "")
+		if nil != err {
+			return err
+		}
+
+		if 1 == card.Main {
+			return uuc.repo.UpdateCard(ctx, user.ID, resCreatCard.Data.CardOrderID, resCreatCard.Data.CardID)
+		}
+		return nil
+	}); nil != err {
+		fmt.Println("开卡后，写入mysql错误", err, user.ID, card.ID, resCreatCard.Data.CardID)
+		return err
+	}
+
+	return nil
+}
+
+// openCardCheck 建卡中的卡按 referenceCode 查询发卡方订单：查到的落库，查无订单的退回已申请重新建卡
+func (uuc *UserUseCase) openCardCheck(ctx context.Context, user *User, card *UserCard) error {
+	resOrder, err := uuc.card.QueryCardOrder(ctx, card.OpenReferenceCode())
+	if nil != err {
+		return err
+	}
+	if nil == resOrder {
+		return errors.New(500, "CARD_ERROR", "发卡方查询失败")
+	}
+
+	if CardOrderNotFound == resOrder.Code {
+		return uuc.userCardTransition(ctx, card, UserCardApplied, "发卡方无建卡订单")
+	}
+	if 200 != resOrder.Code || 0 >= len(resOrder.Data.CardID) || 0 >= len(resOrder.Data.CardOrderID) {
+		fmt.Println("建卡订单查询结果错误", user.ID, card.ID, resOrder.Code, resOrder.Msg)
+		return nil
+	}
+
+	return uuc.openCardSave(ctx, user, card, resOrder)
+}
+
+// submitCardHolder 提交持卡人资料，成功后进入审核中
+func (uuc *UserUseCase) submitCardHolder(ctx context.Context, user *User) error {
+	var (
+		product            *CardProductInfo
+		productIdUseInt64  uint64
+		resCreatCardholder *CreateCardholderResponse
+		err                error
+	)
+
+	// 重新提交的沿用原产品，新提交的取所在区域的默认产品
+	if CardHolderSubmitted == user.CardUserStatus {
+		product, err = uuc.repo.GetCardProductByProductId(user.ProductId)
+	} else {
+		product, err = uuc.repo.GetCardProductDefault(user.VipTwo)
+	}
+	if nil == product || !product.Usable() {
+		fmt.Println("无可用产品", user.ID, product, err)
+		return nil
+	}
+	productIdUseInt64, err = strconv.ParseUint(product.ProductId, 10, 64)
+	if nil != err {
+		fmt.Println("产品信息错误", product, err)
+		return nil
+	}
+
+	// 上次提交结果未知的，先按 referenceCode 查询发卡方，查无订单的用原 referenceCode 重新提交
+	referenceCode := user.CardUserId
+	if CardHolderSubmitted == user.CardUserStatus && strings.HasPrefix(referenceCode, cardHolderReferencePrefix) {
 		var (
-			userRecommend *UserRecommend
+			resOrder *CreateCardholderResponse
 		)
-		tmpRecommendUserIds := make([]string, 0)
-		// 推荐
-		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
-		if nil == userRecommend {
-			fmt.Println(err, "信息错误", err, user)
+		resOrder, err = uuc.card.QueryCardHolderOrder(ctx, referenceCode)
+		if nil == resOrder || nil != err {
+			fmt.Println("持卡人订单查询失败", user.ID, referenceCode, err)
 			return nil
 		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		if CardOrderNotFound != resOrder.Code {
+			return uuc.submitCardHolderResult(ctx, user, resOrder)
+		}
+	} else {
+		// 先记 submitted 和 referenceCode 再请求发卡方，card_user_id 在审核通过前存 referenceCode
+		referenceCode = fmt.Sprintf("%s%d%d", cardHolderReferencePrefix, user.ID, time.Now().UnixNano())
+		if CardHolderSubmitted == user.CardUserStatus {
+			err = uuc.repo.UpdateCardUserStatus(ctx, user.ID, []string{CardHolderSubmitted}, CardHolderSubmitted, referenceCode)
+		} else {
+			err = uuc.repo.UpdateCardUserSubmitted(ctx, user.ID, product.ProductId, product.MaxCardQuota, referenceCode)
+		}
+		if nil != err {
+			fmt.Println("持卡人状态修改失败", user.ID, err)
+			return nil
 		}
+	}
+
+	resCreatCardholder, err = uuc.card.CreateCardholder(ctx, productIdUseInt64, user, referenceCode)
+	if nil != err && errors.Is(err, ErrIssuerRejected) {
+		fmt.Println("持卡人订单被拒绝", user.ID, err)
+		return uuc.rejectCardHolder(ctx, user.ID, err.Error())
+	}
+	if nil == resCreatCardholder || nil != err {
+		// 未发出或结果未知，下次按 referenceCode 查询后再决定是否重新提交
+		fmt.Println("持卡人订单创建失败", user.ID, err)
+		return nil
+	}
+
+	return uuc.submitCardHolderResult(ctx, user, resCreatCardholder)
+}
+
+// cardHolderReferencePrefix 持卡人提交 referenceCode 前缀，和发卡方持卡人id区分
+const cardHolderReferencePrefix = "CH"
+
+// submitCardHolderResult 发卡方返回的持卡人订单，成功的进入审核中，失败的退款
+func (uuc *UserUseCase) submitCardHolderResult(ctx context.Context, user *User, resCreatCardholder *CreateCardholderResponse) error {
+	if 200 != resCreatCardholder.Code || 5 > len(resCreatCardholder.Data.HolderID) {
+		fmt.Println("持卡人订单信息错误", user.ID, resCreatCardholder.Code, resCreatCardholder.Msg)
+		return uuc.rejectCardHolder(ctx, user.ID, resCreatCardholder.Msg)
+	}
+	fmt.Println("持卡人信息", user.ID, resCreatCardholder.Data.HolderID)
+
+	err := uuc.repo.UpdateCardUserStatus(ctx, user.ID, []string{CardHolderSubmitted}, CardHolderPending, resCreatCardholder.Data.HolderID)
+	if nil != err {
+		fmt.Println("持卡人状态修改失败", user.ID, err)
+	}
+
+	return nil
+}
+
+// rejectCardHolder 持卡人审核失败，开卡中的用户退款
+func (uuc *UserUseCase) rejectCardHolder(ctx context.Context, userId uint64, remark string) error {
+	var (
+		user  *User
+		cards []*UserCard
+		err   error
+	)
+	user, err = uuc.repo.GetUserById(userId)
+	if nil == user {
+		return err
+	}
+
+	// 只有已申请、未建卡的退开卡费
+	cards, err = uuc.repo.GetUserCardsByUserId(userId, UserCardApplied)
+	if nil != err {
+		return err
+	}
+
+	if CardHolderRejected == user.CardUserStatus && 0 >= len(cards) {
+		return nil
+	}
 
-		tmpTopVip := uint64(10)
-		if 30 == user.VipTwo {
-			tmpTopVip = 30
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.repo.UpdateCardUserStatus(ctx, user.ID, []string{CardHolderNone, CardHolderSubmitted, CardHolderPending, CardHolderActive}, CardHolderRejected, "")
+		if nil != err {
+			return err
 		}
-		totalTmp := len(tmpRecommendUserIds) - 1
-		lastVip := uint64(0)
-		for i := totalTmp; i >= 0; i-- {
-			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-			if 0 >= tmpUserId {
-				continue
-			}
 
-			if _, ok := usersMap[tmpUserId]; !ok {
-				fmt.Println("开卡遍历，信息缺失：", tmpUserId)
-				continue
-			}
+		err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordHolderFail, remark, "", "")
+		if nil != err {
+			return err
+		}
 
-			if usersMap[tmpUserId].VipTwo != user.VipTwo {
-				fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", usersMap[tmpUserId], user)
-				continue
+		for _, card := range cards {
+			backAmount := uuc.cardRefundAmount(card, user)
+			if 1 == card.Main {
+				err = uuc.repo.UpdateCardNo(ctx, user.ID, backAmount)
+			} else {
+				err = uuc.repo.UpdateCardOpenBack(ctx, user.ID, backAmount)
 			}
-
-			if tmpTopVip < usersMap[tmpUserId].Vip {
-				fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
-				break
+			if nil != err {
+				return err
 			}
 
-			// 小于等于上一个级别，跳过
-			if usersMap[tmpUserId].Vip <= lastVip {
-				continue
+			err = uuc.userCardTransition(ctx, card, UserCardFailed, remark)
+			if nil != err {
+				return err
 			}
+		}
 
-			tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
-			lastVip = usersMap[tmpUserId].Vip
+		return nil
+	}); nil != err {
+		fmt.Println("持卡人失败，回滚了用户失败", user.ID, err)
+		return err
+	}
 
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-				return nil
-			}); nil != err {
-				fmt.Println("err reward", err, user, usersMap[tmpUserId])
-			}
+var cardProductLockHandle sync.Mutex
+
+// CardProductSyncHandle 同步发卡方产品目录，新产品默认启用，后台启用状态不覆盖
+func (uuc *UserUseCase) CardProductSyncHandle(ctx context.Context) error {
+	cardProductLockHandle.Lock()
+	defer cardProductLockHandle.Unlock()
+
+	var (
+		products *CardProductListResponse
+		err      error
+	)
+	products, err = uuc.card.GetCardProducts(ctx)
+	if nil != err {
+		return err
+	}
+	if nil == products || 200 != products.Code {
+		return errors.New(500, "CARD_PRODUCT_ERROR", "产品信息错误")
+	}
+
+	for _, v := range products.Rows {
+		if 0 >= len(v.ProductId) {
+			continue
+		}
+
+		maxCardQuota := uint64(0)
+		if 0 < v.MaxCardQuota {
+			maxCardQuota = uint64(v.MaxCardQuota)
+		}
+
+		err = uuc.repo.SaveCardProduct(ctx, &CardProductInfo{
+			ProductId:     v.ProductId,
+			ProductName:   v.ProductName,
+			ModeType:      v.ModeType,
+			CardBin:       v.CardBin,
+			CardScheme:    v.CardScheme,
+			CardForm:      strings.Join(v.CardForm, ","),
+			CardCurrency:  strings.Join(v.CardCurrency, ","),
+			MaxCardQuota:  maxCardQuota,
+			ProductStatus: v.ProductStatus,
+		})
+		if nil != err {
+			fmt.Println("产品同步失败", v, err)
 		}
 	}
 
 	return nil
 }
 
-var cardTwoStatusLockHandle sync.Mutex
+// cardIssuerLocation 发卡方接口时间为东八区
+var cardIssuerLocation = time.FixedZone("UTC+8", 8*3600)
 
-func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
-	cardTwoStatusLockHandle.Lock()
-	defer cardTwoStatusLockHandle.Unlock()
+var cardTransactionLockHandle sync.Mutex
+
+// CardTransactionSyncHandle 同步发卡方交易流水，按 transactionId 幂等写入
+func (uuc *UserUseCase) CardTransactionSyncHandle(ctx context.Context) error {
+	cardTransactionLockHandle.Lock()
+	defer cardTransactionLockHandle.Unlock()
 
 	var (
-		userOpenCard []*Reward
+		lastTime     time.Time
+		transactions *CardTransactionListResponse
+		usersMap     map[string]uint64
+		pageSize     = 100
 		err          error
 	)
 
-	var (
-		configs       []*Config
-		vipThreeThree uint64
-		vipThreeTwo   uint64
-		vipThreeOne   uint64
-	)
+	lastTime, err = uuc.repo.GetCardTransactionLastTime()
+	if nil != err {
+		return err
+	}
 
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("vip_three_three", "vip_three_two", "vip_three_one")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "vip_three_three" == vConfig.KeyName {
-				vipThreeThree, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+	// 授权到结算有延迟，已同步的最后时间再往前拉一天，首次拉30天
+	endTime := time.Now()
+	startTime := endTime.Add(-30 * 24 * time.Hour)
+	if !lastTime.IsZero() {
+		startTime = lastTime.Add(-24 * time.Hour)
+	}
+
+	usersMap = make(map[string]uint64, 0)
+	for pageNum := 1; ; pageNum++ {
+		transactions, err = uuc.card.GetCardTransactions(ctx,
+			startTime.In(cardIssuerLocation).Format("2006-01-02 15:04:05"),
+			endTime.In(cardIssuerLocation).Format("2006-01-02 15:04:05"),
+			pageNum, pageSize)
+		if nil != err {
+			return err
+		}
+		if nil == transactions || 200 != transactions.Code {
+			fmt.Println("交易流水查询失败", transactions)
+			return errors.New(500, "CARD_TRANSACTION_ERROR", "交易流水查询失败")
+		}
+
+		for _, v := range transactions.Rows {
+			if 0 >= len(v.TransactionId) {
+				continue
 			}
-			if "vip_three_two" == vConfig.KeyName {
-				vipThreeTwo, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+
+			// 已销卡的卡片查不到用户，保留库里原有的 user_id
+			if _, ok := usersMap[v.CardId]; !ok {
+				var user *User
+				user, err = uuc.repo.GetUserByCard(v.CardId)
+				if nil != err {
+					return err
+				}
+
+				usersMap[v.CardId] = 0
+				if nil != user {
+					usersMap[v.CardId] = user.ID
+				}
 			}
-			if "vip_three_one" == vConfig.KeyName {
-				vipThreeOne, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+
+			var (
+				amount          float64
+				transactionTime time.Time
+			)
+			amount, err = strconv.ParseFloat(v.Amount, 64)
+			if nil != err {
+				fmt.Println("交易金额错误", v, err)
+				continue
 			}
-		}
+			transactionTime, err = time.ParseInLocation("2006-01-02 15:04:05", v.TransactionTime, cardIssuerLocation)
+			if nil != err {
+				fmt.Println("交易时间错误", v, err)
+				continue
+			}
+
+			err = uuc.repo.SaveCardTransaction(ctx, &CardTransaction{
+				UserId:          usersMap[v.CardId],
+				CardId:          v.CardId,
+				TransactionId:   v.TransactionId,
+				TransactionType: v.TransactionType,
+				Status:          v.TransactionStatus,
+				Amount:          amount,
+				Currency:        v.Currency,
+				MerchantName:    v.MerchantName,
+				Mcc:             v.Mcc,
+				TransactionTime: transactionTime.UTC(),
+			})
+			if nil != err {
+				fmt.Println("交易流水保存失败", v, err)
+			}
+		}
+
+		if len(transactions.Rows) < pageSize || pageNum*pageSize >= transactions.Total {
+			break
+		}
+	}
+
+	return nil
+}
+
+var cardBalanceLockHandle sync.Mutex
+
+// CardBalanceHandle 拉取已激活卡片的发卡方余额，和本地充值、交易流水对账
+func (uuc *UserUseCase) CardBalanceHandle(ctx context.Context) error {
+	cardBalanceLockHandle.Lock()
+	defer cardBalanceLockHandle.Unlock()
+
+	var (
+		cards []*UserCard
+		err   error
+	)
+
+	cards, err = uuc.repo.GetUserCardsByStatus(UserCardActive, UserCardFrozen)
+	if nil != err {
+		return err
+	}
+
+	for _, card := range cards {
+		var (
+			cardInfo *CardInfoResponse
+			balance  float64
+			recharge float64
+			refund   float64
+			settle   float64
+		)
+
+		cardInfo, err = uuc.card.GetCardInfo(ctx, card.CardId)
+		if nil != err || nil == cardInfo || 200 != cardInfo.Code {
+			fmt.Println("卡片余额查询失败", card.UserId, card.CardId, cardInfo, err)
+			continue
+		}
+
+		balance, err = strconv.ParseFloat(cardInfo.Data.CardAmount, 64)
+		if nil != err {
+			fmt.Println("卡片余额错误", card.UserId, cardInfo, err)
+			continue
+		}
+
+		recharge, err = uuc.repo.GetCardRechargeSum(card.CardId)
+		if nil != err {
+			return err
+		}
+		refund, err = uuc.repo.GetCardTransactionSum(card.CardId, "REFUND", "SUCCESS")
+		if nil != err {
+			return err
+		}
+		settle, err = uuc.repo.GetCardTransactionSum(card.CardId, "SETTLE", "SUCCESS")
+		if nil != err {
+			return err
+		}
+
+		expected := recharge + refund - settle
+		drift := uint64(0)
+		if 0.01 <= math.Abs(expected-balance) {
+			drift = 1
+		}
+
+		err = uuc.repo.SaveCardBalance(ctx, &CardBalance{
+			UserId:    card.UserId,
+			CardId:    card.CardId,
+			Balance:   balance,
+			Expected:  expected,
+			Drift:     drift,
+			CheckedAt: time.Now(),
+		})
+		if nil != err {
+			fmt.Println("卡片余额保存失败", card.UserId, err)
+		}
+	}
+
+	return nil
+}
+
+// CardApply 后台为用户再开一张卡，扣开卡费，受用户开卡数(配置 user_card_max，默认1)和产品开卡数限制
+func (uuc *UserUseCase) CardApply(ctx context.Context, userId uint64, productId string) (*UserCard, error) {
+	var (
+		user        *User
+		product     *CardProductInfo
+		cards       []*UserCard
+		configs     []*Config
+		userCardMax uint64 = 1
+		err         error
+	)
+
+	// 和 OpenCardHandle 互斥
+	lockHandle.Lock()
+	defer lockHandle.Unlock()
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == user {
+		return nil, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	product, err = uuc.repo.GetCardProductByProductId(productId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == product || !product.Usable() {
+		return nil, errors.New(500, "PRODUCT_ERROR", "产品不可用")
+	}
+
+	configs, err = uuc.repo.GetConfigByKeys("user_card_max")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "user_card_max" == vConfig.KeyName {
+				userCardMax, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	cards, err = uuc.repo.GetUserCardsByUserId(user.ID, UserCardCurrentStatus...)
+	if nil != err {
+		return nil, err
+	}
+	if uint64(len(cards)) >= userCardMax {
+		return nil, errors.New(500, "CARD_LIMIT_ERROR", "开卡数量已达上限")
+	}
+
+	if 0 < product.UserCardMax {
+		productCount := uint64(0)
+		for _, v := range cards {
+			if v.ProductId == product.ProductId {
+				productCount++
+			}
+		}
+		if productCount >= product.UserCardMax {
+			return nil, errors.New(500, "CARD_LIMIT_ERROR", "该产品开卡数量已达上限")
+		}
+	}
+
+	amount := uuc.cardFee(user, product.ProductId)
+	if amount > user.Amount {
+		return nil, errors.New(500, "AMOUNT_ERROR", "余额不足")
+	}
+
+	// 用户没有主卡时作为主卡，同步 user 表
+	card := &UserCard{
+		UserId:    user.ID,
+		ProductId: product.ProductId,
+		Status:    UserCardApplied,
+	}
+	if "no" == user.CardOrderId {
+		card.Main = 1
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.CreateUserCardApply(ctx, card, amount)
+	}); nil != err {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// CardRecharge 余额充值到卡：扣余额、记充值订单，由 CardRechargeHandle 提交发卡方
+func (uuc *UserUseCase) CardRecharge(ctx context.Context, userId uint64, cardId string, amount float64) (*CardRecharge, error) {
+	var (
+		user *User
+		err  error
+	)
+
+	if 0 >= amount {
+		return nil, errors.New(500, "AMOUNT_ERROR", "充值金额错误")
+	}
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == user {
+		return nil, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	_, card, err := uuc.cardActiveUser(user.ID, cardId)
+	if nil != err {
+		return nil, err
+	}
+	if UserCardActive != card.Status {
+		return nil, errors.New(500, "CARD_ERROR", "卡片已冻结")
+	}
+
+	if amount > user.Amount {
+		return nil, errors.New(500, "AMOUNT_ERROR", "余额不足")
+	}
+
+	recharge := &CardRecharge{
+		UserId:        user.ID,
+		CardId:        card.CardId,
+		Amount:        amount,
+		ReferenceCode: fmt.Sprintf("RC%d%d", user.ID, time.Now().UnixNano()),
+		Status:        "pending",
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.CreateCardRecharge(ctx, recharge)
+	}); nil != err {
+		return nil, err
+	}
+
+	return recharge, nil
+}
+
+var cardRechargeLockHandle sync.Mutex
+
+// CardRechargeHandle 提交待处理的充值订单，网络错误的下次用同一个 referenceCode 重试
+func (uuc *UserUseCase) CardRechargeHandle(ctx context.Context) error {
+	cardRechargeLockHandle.Lock()
+	defer cardRechargeLockHandle.Unlock()
+
+	var (
+		recharges []*CardRecharge
+		err       error
+	)
+
+	recharges, err = uuc.repo.GetCardRechargesPending(100)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range recharges {
+		var (
+			res *RechargeCardResponse
+		)
+
+		res, err = uuc.card.RechargeCard(ctx, v.CardId, v.Amount, v.ReferenceCode)
+		if nil != err || nil == res {
+			fmt.Println("充值请求错误", v, res, err)
+			continue
+		}
+
+		if 200 != res.Code {
+			fmt.Println("充值失败", v, res)
+			err = uuc.cardRechargeFail(ctx, v.ReferenceCode, res.Msg)
+			if nil != err {
+				fmt.Println("充值失败，退回失败", v, err)
+			}
+			continue
+		}
+
+		err = uuc.repo.UpdateCardRecharge(ctx, v.ID, []string{"pending"}, "doing", res.Data.OrderId, "")
+		if nil != err {
+			fmt.Println("充值订单修改失败", v, err)
+		}
+	}
+
+	return nil
+}
+
+// cardRechargeFail 充值订单失败并退回余额，只会退一次
+func (uuc *UserUseCase) cardRechargeFail(ctx context.Context, referenceCode string, remark string) error {
+	var (
+		recharge *CardRecharge
+		err      error
+	)
+
+	recharge, err = uuc.repo.GetCardRechargeByReferenceCode(referenceCode)
+	if nil == recharge {
+		fmt.Println("不存在充值订单", referenceCode, err)
+		return err
+	}
+	if "fail" == recharge.Status {
+		return nil
+	}
+	if "success" == recharge.Status {
+		fmt.Println("充值订单已成功，忽略失败", recharge, remark)
+		return nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.repo.UpdateCardRecharge(ctx, recharge.ID, []string{"pending", "doing"}, "fail", "", remark)
+		if nil != err {
+			return err
+		}
+
+		return uuc.repo.CardRechargeBack(ctx, recharge)
+	}); nil != err {
+		return err
+	}
+
+	return nil
+}
+
+// cardActiveUser 卡片操作只针对已激活或已冻结的卡，cardId 为空时取用户最新的卡
+func (uuc *UserUseCase) cardActiveUser(userId uint64, cardId string) (*User, *UserCard, error) {
+	var (
+		user *User
+		card *UserCard
+		err  error
+	)
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, nil, err
+	}
+	if nil == user {
+		return nil, nil, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	if "" != cardId {
+		card, err = uuc.repo.GetUserCardByCardId(cardId)
+		if nil != card && userId != card.UserId {
+			card = nil
+		}
+	} else {
+		card, err = uuc.repo.GetUserCardCurrent(userId)
+	}
+	if nil != err {
+		return nil, nil, err
+	}
+	if nil == card || (UserCardActive != card.Status && UserCardFrozen != card.Status) {
+		return nil, nil, errors.New(500, "CARD_ERROR", "卡片未激活")
+	}
+
+	return user, card, nil
+}
+
+// CardFreeze 冻结或解冻
+func (uuc *UserUseCase) CardFreeze(ctx context.Context, userId uint64, cardId string, freeze bool) error {
+	var (
+		user       *User
+		card       *UserCard
+		res        *CardOperateResponse
+		recordType = CardRecordUnfreeze
+		status     = UserCardActive
+		err        error
+	)
+
+	user, card, err = uuc.cardActiveUser(userId, cardId)
+	if nil != err {
+		return err
+	}
+
+	if freeze {
+		recordType = CardRecordFreeze
+		status = UserCardFrozen
+	}
+	if err = checkUserCardTransition(card.Status, status); nil != err {
+		return err
+	}
+
+	if freeze {
+		res, err = uuc.card.FreezeCard(ctx, card.CardId)
+	} else {
+		res, err = uuc.card.UnfreezeCard(ctx, card.CardId)
+	}
+	if nil != err {
+		return errors.New(500, "CARD_ERROR", err.Error())
+	}
+	if nil == res || 200 != res.Code {
+		fmt.Println("冻结解冻失败", user.ID, card.CardId, res)
+		return errors.New(500, "CARD_ERROR", "发卡方处理失败")
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.userCardTransition(ctx, card, status, "admin")
+		if nil != err {
+			return err
+		}
+
+		return uuc.repo.InsertCardRecord(ctx, user.ID, recordType, res.Data.CardStatus, card.CardId, "admin")
+	})
+}
+
+// CardCancel 销卡，卡内余额退回用户余额
+func (uuc *UserUseCase) CardCancel(ctx context.Context, userId uint64, cardId string) error {
+	var (
+		user  *User
+		card  *UserCard
+		res   *CardOperateResponse
+		count int64
+		err   error
+	)
+
+	// 和充值互斥，有未完成的充值不能销卡
+	cardRechargeLockHandle.Lock()
+	defer cardRechargeLockHandle.Unlock()
+
+	user, card, err = uuc.cardActiveUser(userId, cardId)
+	if nil != err {
+		return err
+	}
+
+	for _, status := range []string{"pending", "doing"} {
+		_, err, count = uuc.repo.GetCardRecharges(&Pagination{PageNum: 1, PageSize: 1}, user.ID, status)
+		if nil != err {
+			return err
+		}
+		if 0 < count {
+			return errors.New(500, "CARD_ERROR", "有未完成的充值")
+		}
+	}
+
+	// 先记销卡中再请求发卡方，请求后写库失败的由回调或 CardStatusHandle 按发卡方状态结算
+	from := card.Status
+	err = uuc.userCardTransition(ctx, card, UserCardCancelling, "admin")
+	if nil != err {
+		return err
+	}
+	card.Status = UserCardCancelling
+
+	res, err = uuc.card.CancelCard(ctx, card.CardId)
+	if nil != err {
+		fmt.Println("销卡请求错误", user.ID, card.CardId, err)
+		return errors.New(500, "CARD_ERROR", "销卡处理中，结果以发卡方为准")
+	}
+	if nil == res || 200 != res.Code {
+		fmt.Println("销卡失败", user.ID, card.CardId, res)
+		if err = uuc.userCardTransition(ctx, card, from, "发卡方销卡失败"); nil != err {
+			fmt.Println("销卡失败，状态回退失败", user.ID, card.CardId, err)
+		}
+		return errors.New(500, "CARD_ERROR", "发卡方处理失败")
+	}
+
+	return uuc.cardCancelSettle(ctx, card, res.Data.Balance)
+}
+
+// cardCancelSettle 发卡方已销卡，退回卡内余额，余额解析不了的不结算，留在销卡中等人工核对
+func (uuc *UserUseCase) cardCancelSettle(ctx context.Context, card *UserCard, balanceStr string) error {
+	balance, err := strconv.ParseFloat(balanceStr, 64)
+	if nil != err || 0 > balance {
+		fmt.Println("销卡余额错误", card.UserId, card.CardId, balanceStr)
+		return errors.New(500, "CARD_ERROR", "销卡余额错误")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 状态条件更新放在前面，重复结算的在这里失败，不会重复退款
+		err = uuc.userCardTransition(ctx, card, UserCardCancelled, fmt.Sprintf("balance=%.2f", balance))
+		if nil != err {
+			return err
+		}
+
+		err = uuc.repo.CancelCard(ctx, card.UserId, card.CardId, balance)
+		if nil != err {
+			return err
+		}
+
+		return uuc.repo.InsertCardRecord(ctx, card.UserId, CardRecordCancel, fmt.Sprintf("balance=%.2f", balance), card.CardId, "admin")
+	}); nil != err {
+		fmt.Println("销卡后，写入mysql错误", err, card.UserId, card.CardId)
+		return err
+	}
+
+	return nil
+}
+
+// cardCancelHandle 销卡中的卡片按发卡方状态结算：已销卡的退余额，未销卡的退回原状态
+func (uuc *UserUseCase) cardCancelHandle(ctx context.Context) error {
+	var (
+		cards []*UserCard
+		err   error
+	)
+
+	// 和 CardCancel 互斥，避免请求还没返回就被回退
+	cardRechargeLockHandle.Lock()
+	defer cardRechargeLockHandle.Unlock()
+
+	cards, err = uuc.repo.GetUserCardsByStatus(UserCardCancelling)
+	if nil != err {
+		return err
+	}
+
+	for _, card := range cards {
+		err = uuc.cardCancelCheck(ctx, card)
+		if nil != err {
+			fmt.Println("销卡结算失败", card.ID, card.CardId, err)
+		}
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) cardCancelCheck(ctx context.Context, card *UserCard) error {
+	resCard, err := uuc.card.GetCardInfo(ctx, card.CardId)
+	if nil != err {
+		return err
+	}
+	if nil == resCard || 200 != resCard.Code {
+		return errors.New(500, "CARD_ERROR", "发卡方查询失败")
+	}
+
+	switch resCard.Data.CardStatus {
+	case "CANCELLED":
+		return uuc.cardCancelSettle(ctx, card, resCard.Data.CardAmount)
+	case "ACTIVE":
+		return uuc.userCardTransition(ctx, card, UserCardActive, "发卡方未销卡")
+	case "FROZEN":
+		return uuc.userCardTransition(ctx, card, UserCardFrozen, "发卡方未销卡")
+	default:
+		return nil
+	}
+}
+
+// CardLimit 修改卡片日、月消费限额
+func (uuc *UserUseCase) CardLimit(ctx context.Context, userId uint64, cardId string, dailyLimit uint64, monthlyLimit uint64) error {
+	var (
+		user *User
+		card *UserCard
+		res  *CardOperateResponse
+		err  error
+	)
+
+	if 0 >= dailyLimit || dailyLimit > monthlyLimit {
+		return errors.New(500, "LIMIT_ERROR", "限额错误")
+	}
+
+	user, card, err = uuc.cardActiveUser(userId, cardId)
+	if nil != err {
+		return err
+	}
+
+	res, err = uuc.card.UpdateCardLimit(ctx, card.CardId, dailyLimit, monthlyLimit)
+	if nil != err {
+		return errors.New(500, "CARD_ERROR", err.Error())
+	}
+	if nil == res || 200 != res.Code {
+		fmt.Println("修改限额失败", user.ID, card.CardId, res)
+		return errors.New(500, "CARD_ERROR", "发卡方处理失败")
+	}
+
+	return uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordLimit, fmt.Sprintf("daily=%d,monthly=%d", dailyLimit, monthlyLimit), card.CardId, "admin")
+}
+
+var cardStatusLockHandle sync.Mutex
+
+func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
+	cardStatusLockHandle.Lock()
+	defer cardStatusLockHandle.Unlock()
+
+	var (
+		cards []*UserCard
+		err   error
+	)
+
+	err = uuc.cardCancelHandle(ctx)
+	if nil != err {
+		fmt.Println("销卡结算失败", err)
+	}
+
+	cards, err = uuc.repo.GetUserCardsByStatus(UserCardOpening)
+	if nil != err {
+		return err
+	}
+
+	var (
+		users    []*User
+		usersMap map[uint64]*User
+	)
+	users, err = uuc.repo.GetAllUsers()
+	if nil == users {
+		fmt.Println("用户无")
+		return nil
+	}
+
+	usersMap = make(map[uint64]*User, 0)
+	for _, vUsers := range users {
+		usersMap[vUsers.ID] = vUsers
+	}
+
+	if 0 >= len(cards) {
+		return nil
+	}
+
+	for _, card := range cards {
+		if _, ok := usersMap[card.UserId]; !ok {
+			fmt.Println("开卡状态，用户不存在", card.ID, card.UserId)
+			continue
+		}
+
+		err = uuc.cardStatus(ctx, card, usersMap[card.UserId], usersMap)
+		if nil != err {
+			fmt.Println("开卡状态处理失败", err, card.UserId)
+		}
+	}
+
+	return nil
+}
+
+// cardStatus 查询单个卡片状态，激活则分红，失败则退款
+func (uuc *UserUseCase) cardStatus(ctx context.Context, card *UserCard, user *User, usersMap map[uint64]*User) error {
+	var (
+		err error
+	)
+
+	// 查询状态。成功分红
+	var (
+		resCard *CardInfoResponse
+	)
+	if 2 >= len(card.CardId) {
+		return nil
+	}
+
+	resCard, err = uuc.card.GetCardInfo(ctx, card.CardId)
+	if nil == resCard || 200 != resCard.Code || err != nil {
+		fmt.Println("开卡状态查询失败", card.ID, card.CardId, err)
+		return nil
+	}
+
+	if "ACTIVE" == resCard.Data.CardStatus {
+		fmt.Println("开卡状态，激活：", user.ID, card.CardId)
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			if 1 == card.Main {
+				err = uuc.repo.UpdateCardSucces(ctx, user.ID, resCard.Data.Pan)
+				if err != nil {
+					return err
+				}
+			}
+
+			card.CardNumber = resCard.Data.Pan
+			return uuc.userCardTransition(ctx, card, UserCardActive, "")
+		}); nil != err {
+			fmt.Println("err，开卡成功", err, user.ID)
+			return nil
+		}
+	} else if "PENDING" == resCard.Data.CardStatus || "PROGRESS" == resCard.Data.CardStatus {
+		fmt.Println("开卡状态，待处理：", user.ID, card.CardId)
+		return nil
+	} else {
+		fmt.Println("开卡状态，失败：", user.ID, card.CardId, resCard.Data.CardStatus)
+		backAmount := uuc.cardRefundAmount(card, user)
+		err = uuc.backCard(ctx, card, backAmount, resCard.Data.CardStatus)
+		if nil != err {
+			fmt.Println("回滚了用户失败", user.ID, err)
+		}
+		return nil
+	}
+
+	// 分红
+	var (
+		userRecommend *UserRecommend
+	)
+	tmpRecommendUserIds := make([]string, 0)
+	// 推荐
+	userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
+	if nil == userRecommend {
+		fmt.Println(err, "信息错误", err, user.ID)
+		return nil
+	}
+	if "" != userRecommend.RecommendCode {
+		tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+	}
+
+	tmpTopVip := uint64(10)
+	if 30 == user.VipTwo {
+		tmpTopVip = 30
+	}
+	totalTmp := len(tmpRecommendUserIds) - 1
+	lastVip := uint64(0)
+	for i := totalTmp; i >= 0; i-- {
+		tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+		if 0 >= tmpUserId {
+			continue
+		}
+
+		if _, ok := usersMap[tmpUserId]; !ok {
+			fmt.Println("开卡遍历，信息缺失：", tmpUserId)
+			continue
+		}
+
+		if usersMap[tmpUserId].VipTwo != user.VipTwo {
+			fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", tmpUserId, user.ID)
+			continue
+		}
+
+		if tmpTopVip < usersMap[tmpUserId].Vip {
+			fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
+			break
+		}
+
+		// 小于等于上一个级别，跳过
+		if usersMap[tmpUserId].Vip <= lastVip {
+			continue
+		}
+
+		tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
+		lastVip = usersMap[tmpUserId].Vip
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			fmt.Println("err reward", err, user.ID, tmpUserId)
+		}
+	}
+
+	return nil
+}
+
+var cardTwoStatusLockHandle sync.Mutex
+
+// CardTwoStatusHandle 开实体卡记录生成订单，订单到达配置的状态(card_two_reward_status，默认已签收)后分红
+func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
+	cardTwoStatusLockHandle.Lock()
+	defer cardTwoStatusLockHandle.Unlock()
+
+	var (
+		userOpenCard  []*Reward
+		cardTwoOrders []*CardTwoOrder
+		err           error
+	)
+
+	var (
+		configs       []*Config
+		vipThreeThree uint64
+		vipThreeTwo   uint64
+		vipThreeOne   uint64
+		rewardStatus  = CardTwoOrderDelivered
+	)
+
+	// 配置
+	configs, err = uuc.repo.GetConfigByKeys("vip_three_three", "vip_three_two", "vip_three_one", "card_two_reward_status")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "card_two_reward_status" == vConfig.KeyName && 0 <= cardTwoOrderStatusIndex(vConfig.Value) {
+				rewardStatus = vConfig.Value
+			}
+			if "vip_three_three" == vConfig.KeyName {
+				vipThreeThree, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+			if "vip_three_two" == vConfig.KeyName {
+				vipThreeTwo, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+			if "vip_three_one" == vConfig.KeyName {
+				vipThreeOne, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	// 开实体卡记录转为实体卡订单
+	userOpenCard, err = uuc.repo.GetUserCardTwo()
+	if nil != err {
+		return err
+	}
+
+	for _, userCard := range userOpenCard {
+		var (
+			userTmp *User
+		)
+		userTmp, err = uuc.repo.GetUserById(userCard.UserId)
+		if nil != err || nil == userTmp {
+			fmt.Println("开卡2，用户信息缺失：", userCard.ID, userCard.UserId, err)
+			continue
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateCardTwo(ctx, userCard.ID)
+			if err != nil {
+				return err
+			}
+
+			return uuc.repo.CreateCardTwoOrder(ctx, &CardTwoOrder{
+				UserId:     userTmp.ID,
+				RewardId:   userCard.ID,
+				Status:     CardTwoOrderSubmitted,
+				Receiver:   strings.TrimSpace(userTmp.FirstName + " " + userTmp.LastName),
+				Phone:      userTmp.Phone,
+				Country:    userTmp.Country,
+				City:       userTmp.City,
+				Street:     userTmp.Street,
+				PostalCode: userTmp.PostalCode,
+			})
+		}); nil != err {
+			fmt.Println("err reward 2", err, userCard.ID, userCard.UserId)
+			continue
+		}
+	}
+
+	// 到达分红状态的订单
+	cardTwoOrders, err = uuc.repo.GetCardTwoOrdersUnRewarded(cardTwoOrderStatusFrom(rewardStatus))
+	if nil != err {
+		return err
+	}
+
+	if 0 >= len(cardTwoOrders) {
+		return nil
+	}
+
+	var (
+		users    []*User
+		usersMap map[uint64]*User
+	)
+	users, err = uuc.repo.GetAllUsers()
+	if nil == users {
+		fmt.Println("开卡2，用户无")
+		return nil
+	}
+
+	usersMap = make(map[uint64]*User, 0)
+	for _, vUsers := range users {
+		usersMap[vUsers.ID] = vUsers
+	}
+
+	for _, userCard := range cardTwoOrders {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateCardTwoOrderRewarded(ctx, userCard.ID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			fmt.Println("err reward 2", err, userCard.ID, userCard.UserId)
+			continue
+		}
+
+		if _, ok := usersMap[userCard.UserId]; !ok {
+			fmt.Println("开卡2，信息缺失：", userCard.ID, userCard.UserId)
+			continue
+		}
+		user := usersMap[userCard.UserId]
+
+		// 分红
+		var (
+			userRecommend *UserRecommend
+		)
+		tmpRecommendUserIds := make([]string, 0)
+		// 推荐
+		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
+		if nil == userRecommend {
+			fmt.Println(err, "开卡2，信息错误", err, user.ID)
+			return nil
+		}
+		if "" != userRecommend.RecommendCode {
+			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		}
+
+		tmpTopVip := uint64(3)
+		totalTmp := len(tmpRecommendUserIds) - 1
+		lastVip := uint64(0)
+		lastAmount := uint64(0)
+		for i := totalTmp; i >= 0; i-- {
+			if vipThreeThree <= lastAmount {
+				break
+			}
+
+			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+			if 0 >= tmpUserId {
+				continue
+			}
+
+			if _, ok := usersMap[tmpUserId]; !ok {
+				fmt.Println("开卡2遍历，信息缺失：", tmpUserId)
+				continue
+			}
+
+			if tmpTopVip < usersMap[tmpUserId].VipThree {
+				fmt.Println("开卡2遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
+				break
+			}
+
+			// 小于等于上一个级别，跳过
+			if usersMap[tmpUserId].VipThree <= lastVip {
+				continue
+			}
+			lastVip = usersMap[tmpUserId].VipThree
+
+			// 奖励
+			tmpAmount := uint64(0)
+			if 1 == usersMap[tmpUserId].VipThree {
+				if vipThreeOne <= lastAmount {
+					fmt.Println("开卡2遍历，vip奖励信息设置错误1：", usersMap[tmpUserId], lastVip, vipThreeOne, lastAmount)
+					continue
+				}
+
+				tmpAmount = vipThreeOne - lastAmount
+				lastAmount = vipThreeOne
+			} else if 2 == usersMap[tmpUserId].VipThree {
+				if vipThreeTwo <= lastAmount {
+					fmt.Println("开卡2遍历，vip奖励信息设置错误2：", usersMap[tmpUserId], lastVip, vipThreeTwo, lastAmount)
+					continue
+				}
+
+				tmpAmount = vipThreeTwo - lastAmount
+				lastAmount = vipThreeTwo
+			} else if 3 == usersMap[tmpUserId].VipThree {
+				if vipThreeThree <= lastAmount {
+					fmt.Println("开卡2遍历，vip奖励信息设置错误3：", usersMap[tmpUserId], lastVip, vipThreeThree, lastAmount)
+					continue
+				}
+
+				tmpAmount = vipThreeThree - lastAmount
+				lastAmount = vipThreeThree
+			} else {
+				continue
+			}
+
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			}); nil != err {
+				fmt.Println("err reward 2", err, user.ID, tmpUserId)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backCard 开卡失败，退开卡费
+func (uuc *UserUseCase) backCard(ctx context.Context, card *UserCard, amount float64, remark string) error {
+	var (
+		err error
+	)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if 1 == card.Main {
+			err = uuc.repo.UpdateCardNo(ctx, card.UserId, amount)
+		} else {
+			err = uuc.repo.UpdateCardOpenBack(ctx, card.UserId, amount)
+		}
+		if err != nil {
+			return err
+		}
+
+		return uuc.userCardTransition(ctx, card, UserCardFailed, remark)
+	}); nil != err {
+		fmt.Println("err")
+		return err
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
+	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
+}
+
+func (uuc *UserUseCase) GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error) {
+	return uuc.repo.GetUserByUserIds(userIds...)
+}
+
+// UpdateWithdrawDoing 领取一笔待打款的提现，已被其他任务领走时报错
+func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id uint64) (*Withdraw, error) {
+	return uuc.repo.UpdateWithdraw(ctx, id, []string{WithdrawApproved}, WithdrawDoing)
+}
+
+// UpdateWithdrawSuccess 只有自己领取的或已广播的才能置成功
+func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id uint64) (*Withdraw, error) {
+	return uuc.repo.UpdateWithdraw(ctx, id, []string{WithdrawDoing, WithdrawSent}, WithdrawSuccess)
+}
+
+// withdrawDoingTimeout 领取后多久还没落交易算任务中断，签名只在本地，正常几秒内完成
+const withdrawDoingTimeout = 10 * time.Minute
+
+// WithdrawDoingRequeue 任务在领取和落交易之间中断的提现放回待打款，没有交易落库也就没有广播过
+func (uuc *UserUseCase) WithdrawDoingRequeue(ctx context.Context) error {
+	count, err := uuc.repo.UpdateWithdrawDoingTimeout(ctx, time.Now().Add(-withdrawDoingTimeout))
+	if nil != err {
+		return err
+	}
+	if 0 < count {
+		fmt.Println("提现打款中超时放回待打款", count)
+	}
+
+	return nil
+}
+
+// WithdrawSign 分配 nonce 并签名，交易和下一个 nonce 在同一个事务落库，签名失败不会占用 nonce
+// nonce 取库里记录和链上已确认 nonce 的较大值，多个任务并发时行锁保证不重复
+// 持有 nonce 行锁期间不访问节点，sign 只能用事先取好的 gas 在本地签名
+func (uuc *UserUseCase) WithdrawSign(ctx context.Context, claimed *Withdraw, sign func(nonce uint64) (*WithdrawTx, error)) (*WithdrawTx, error) {
+	var (
+		address    = uuc.chain.WalletAddress()
+		chainNonce uint64
+		wt         *WithdrawTx
+		err        error
+	)
+
+	chainNonce, err = uuc.chain.WalletNonce(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		nonce, errTwo := uuc.repo.GetWalletNonce(ctx, address)
+		if nil != errTwo {
+			return errTwo
+		}
+		if chainNonce > nonce {
+			nonce = chainNonce
+		}
+
+		wt, errTwo = sign(nonce)
+		if nil != errTwo {
+			return errTwo
+		}
+		wt.WithdrawId = claimed.ID
+		wt.Nonce = nonce
+		wt.Kind = WithdrawTxSend
+
+		errTwo = uuc.repo.UpdateWithdrawSent(ctx, claimed.ID, claimed.UpdatedAt, wt.TxHash, nonce, wt.RawTx)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		errTwo = uuc.repo.CreateWithdrawTx(ctx, wt)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		return uuc.repo.SaveWalletNonce(ctx, address, nonce+1)
+	}); nil != err {
+		return nil, err
+	}
+
+	return wt, nil
+}
+
+// 可以人工审核的提现状态，打款失败的也可以重新通过或驳回
+var withdrawAuditFrom = []string{WithdrawRewarded, WithdrawPendingReview, WithdrawFailed}
+
+// withdrawRisk 自动审核规则，返回转人工的原因，空为通过
+// withdraw_auto_max 单笔上限，未配置时全部人工审核；withdraw_auto_daily_max 单用户每日累计上限；
+// withdraw_auto_min_hours 注册满多少小时
+func (uuc *UserUseCase) withdrawRisk(w *Withdraw, configs []*Config) (string, error) {
+	var (
+		autoMax      float64
+		autoDailyMax float64
+		autoMinHours uint64
+	)
+	for _, vConfig := range configs {
+		if "withdraw_auto_max" == vConfig.KeyName {
+			autoMax, _ = strconv.ParseFloat(vConfig.Value, 64)
+		}
+		if "withdraw_auto_daily_max" == vConfig.KeyName {
+			autoDailyMax, _ = strconv.ParseFloat(vConfig.Value, 64)
+		}
+		if "withdraw_auto_min_hours" == vConfig.KeyName {
+			autoMinHours, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+		}
+	}
+
+	if 0 >= autoMax || w.Amount > autoMax {
+		return "超过单笔自动审核额度", nil
+	}
+
+	user, err := uuc.repo.GetUserById(w.UserId)
+	if nil != err {
+		return "", err
+	}
+	if nil == user {
+		return "用户不存在", nil
+	}
+	if 0 < autoMinHours && time.Since(user.CreatedAt) < time.Duration(autoMinHours)*time.Hour {
+		return "注册时间不足", nil
+	}
+
+	if 0 < autoDailyMax {
+		// 按北京时间自然日累计
+		now := time.Now().UTC().Add(8 * time.Hour)
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(-8 * time.Hour)
+
+		var sum float64
+		sum, err = uuc.repo.GetWithdrawAmountSum(w.UserId, start, w.ID)
+		if nil != err {
+			return "", err
+		}
+		if sum+w.Amount > autoDailyMax {
+			return "超过每日自动审核额度", nil
+		}
+	}
+
+	return "", nil
+}
+
+// WithdrawReview 新提现过风控，通过的进入待打款，其余转人工审核
+func (uuc *UserUseCase) WithdrawReview(ctx context.Context) error {
+	var (
+		withdraws []*Withdraw
+		configs   []*Config
+		remark    string
+		err       error
+	)
+
+	withdraws, err = uuc.repo.GetWithdrawsByStatus(WithdrawRewarded, 100)
+	if nil != err {
+		return err
+	}
+	if 0 >= len(withdraws) {
+		return nil
+	}
+
+	configs, err = uuc.repo.GetConfigByKeys("withdraw_auto_max", "withdraw_auto_daily_max", "withdraw_auto_min_hours")
+	if nil != err {
+		return err
+	}
+
+	for _, w := range withdraws {
+		remark, err = uuc.withdrawRisk(w, configs)
+		if nil != err {
+			return err
+		}
+
+		status := WithdrawApproved
+		if "" != remark {
+			status = WithdrawPendingReview
+		}
+
+		err = uuc.repo.UpdateWithdrawReview(ctx, w.ID, []string{WithdrawRewarded}, status, 0, remark)
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendWithdrawTx 广播已落库的交易
+func (uuc *UserUseCase) SendWithdrawTx(ctx context.Context, wt *WithdrawTx) error {
+	return uuc.chain.SendRawTx(ctx, wt.RawTx)
+}
+
+// UpdateWithdrawRequeue 交易没有签出来，放回待打款
+func (uuc *UserUseCase) UpdateWithdrawRequeue(ctx context.Context, id uint64) error {
+	return uuc.repo.UpdateWithdrawRequeue(ctx, id, WithdrawDoing)
+}
+
+// GetWithdrawsApproved 待打款的提现，先审核的先打
+func (uuc *UserUseCase) GetWithdrawsApproved(limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsByStatus(WithdrawApproved, limit)
+}
+
+// WithdrawBatchSign 一批提现签成一笔交易，批次、提现状态、交易和下一个 nonce 在同一个事务落库
+// 任何一笔提现状态已变化整批回滚，不会占用 nonce；和 WithdrawSign 一样 sign 不能访问节点
+func (uuc *UserUseCase) WithdrawBatchSign(ctx context.Context, withdraws []*Withdraw, sign func(nonce uint64) (*WithdrawTx, error)) (*WithdrawTx, error) {
+	var (
+		address    = uuc.chain.WalletAddress()
+		chainNonce uint64
+		wt         *WithdrawTx
+		err        error
+	)
+
+	batch := &WithdrawBatch{
+		Count:  uint64(len(withdraws)),
+		Status: WithdrawSent,
+	}
+	ids := make([]uint64, 0, len(withdraws))
+	for _, w := range withdraws {
+		ids = append(ids, w.ID)
+		batch.Amount += w.RelAmount
+	}
+
+	chainNonce, err = uuc.chain.WalletNonce(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		nonce, errTwo := uuc.repo.GetWalletNonce(ctx, address)
+		if nil != errTwo {
+			return errTwo
+		}
+		if chainNonce > nonce {
+			nonce = chainNonce
+		}
+
+		wt, errTwo = sign(nonce)
+		if nil != errTwo {
+			return errTwo
+		}
+		wt.Nonce = nonce
+		wt.Kind = WithdrawTxSend
+
+		batch.TxHash = wt.TxHash
+		batch.Nonce = nonce
+		wt.BatchId, errTwo = uuc.repo.CreateWithdrawBatch(ctx, batch)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		errTwo = uuc.repo.UpdateWithdrawBatchSent(ctx, ids, wt.BatchId, wt.TxHash, nonce, wt.RawTx)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		errTwo = uuc.repo.CreateWithdrawTx(ctx, wt)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		return uuc.repo.SaveWalletNonce(ctx, address, nonce+1)
+	}); nil != err {
+		return nil, err
+	}
+
+	return wt, nil
+}
+
+// withdrawTxs 提现发出过的交易，新的在前，没有记录的按 withdraw 上的交易处理
+// 批量打款的取批次的交易
+func (uuc *UserUseCase) withdrawTxs(w *Withdraw) ([]*WithdrawTx, error) {
+	var (
+		txs []*WithdrawTx
+		err error
+	)
+	if 0 < w.BatchId {
+		txs, err = uuc.repo.GetWithdrawTxs(0, w.BatchId)
+	} else {
+		txs, err = uuc.repo.GetWithdrawTxs(w.ID, 0)
+	}
+	if nil != err {
+		return nil, err
+	}
+	if 0 >= len(txs) {
+		txs = append(txs, &WithdrawTx{
+			WithdrawId: w.ID,
+			BatchId:    w.BatchId,
+			TxHash:     w.TxHash,
+			Nonce:      w.Nonce,
+			Kind:       WithdrawTxSend,
+			RawTx:      w.RawTx,
+			CreatedAt:  w.UpdatedAt,
+		})
+	}
+
+	return txs, nil
+}
+
+// replaceWithdrawTx 同 nonce 重新签一笔加价或取消的交易，落库后广播
+func (uuc *UserUseCase) replaceWithdrawTx(ctx context.Context, w *Withdraw, last *WithdrawTx, cancel bool) error {
+	wt, err := uuc.chain.ReplaceTx(ctx, last.RawTx, cancel)
+	if nil != err {
+		return err
+	}
+	wt.Kind = WithdrawTxSpeedUp
+	if cancel {
+		wt.Kind = WithdrawTxCancel
+	}
+	if 0 < w.BatchId {
+		wt.BatchId = w.BatchId
+	} else {
+		wt.WithdrawId = w.ID
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		errTwo := uuc.repo.CreateWithdrawTx(ctx, wt)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		if 0 < w.BatchId {
+			return uuc.repo.UpdateWithdrawBatchReplaced(ctx, w.BatchId, wt.TxHash, wt.RawTx)
+		}
+		return uuc.repo.UpdateWithdrawReplaced(ctx, w.ID, wt.TxHash, wt.RawTx)
+	}); nil != err {
+		return err
+	}
+
+	// 已落库，广播失败下次确认任务会重新广播
+	if err = uuc.chain.SendRawTx(ctx, wt.RawTx); nil != err {
+		fmt.Println("withdraw replace broadcast error", w.ID, wt.TxHash, err)
+	}
+
+	return nil
+}
+
+// withdrawRequeue 交易没有上链或被取消，放回待打款，批量的整批放回
+func (uuc *UserUseCase) withdrawRequeue(ctx context.Context, w *Withdraw) error {
+	if 0 >= w.BatchId {
+		return uuc.repo.UpdateWithdrawRequeue(ctx, w.ID, WithdrawSent)
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.UpdateWithdrawBatchRequeue(ctx, w.BatchId)
+	})
+}
+
+// withdrawConfirmed 写入回执结果，批量的整批一起更新
+func (uuc *UserUseCase) withdrawConfirmed(ctx context.Context, w *Withdraw, status string, txHash string, receipt *ChainReceipt) error {
+	if 0 >= w.BatchId {
+		return uuc.repo.UpdateWithdrawConfirmed(ctx, w.ID, status, txHash, receipt.GasUsed, receipt.BlockNumber)
+	}
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.UpdateWithdrawBatchConfirmed(ctx, w.BatchId, status, txHash, receipt.GasUsed, receipt.BlockNumber)
+	})
+}
+
+var withdrawConfirmLockHandle sync.Mutex
+
+// WithdrawConfirmHandle 已广播的提现查回执，确认块数够了才算成功
+// 回执失败的标记失败并告警；同一节点确认块高上 nonce 已被占用却查不到任何一笔交易时，说明交易被丢弃，
+// 连续多次检查都是这样且持续超过 ReplaceAfter 才放回待打款；否则超时的加价替换，未超时的重新广播最新一笔
+func (uuc *UserUseCase) WithdrawConfirmHandle(ctx context.Context, end time.Time) error {
+	withdrawConfirmLockHandle.Lock()
+	defer withdrawConfirmLockHandle.Unlock()
+
+	var (
+		withdraws []*Withdraw
+		txs       []*WithdrawTx
+		state     *ChainTxState
+		err       error
+	)
+
+	withdraws, err = uuc.repo.GetWithdrawsByStatus(WithdrawSent, 100)
+	if nil != err {
+		return err
+	}
+
+	batches := make(map[uint64]bool, 0)
+	for _, w := range withdraws {
+		if time.Now().UTC().After(end) {
+			break
+		}
+
+		// 同一批次只处理一次
+		if 0 < w.BatchId {
+			if batches[w.BatchId] {
+				continue
+			}
+			batches[w.BatchId] = true
+		}
+
+		txs, err = uuc.withdrawTxs(w)
+		if nil != err {
+			return err
+		}
+
+		// 加速或取消过的提现，任何一笔上链都算数，nonce 和回执要在同一个节点上查
+		txHashes := make([]string, 0, len(txs))
+		for _, wt := range txs {
+			txHashes = append(txHashes, wt.TxHash)
+		}
+		state, err = uuc.chain.WithdrawTxState(ctx, txHashes)
+		if nil != err {
+			return err
+		}
+
+		droppedKey := [2]uint64{w.BatchId, w.ID}
+		if 0 < w.BatchId {
+			droppedKey[1] = 0
+		}
+		if nil != state.Receipt || state.Nonce <= w.Nonce {
+			delete(uuc.withdrawDropped, droppedKey)
+		}
+
+		if nil == state.Receipt {
+			if state.Nonce > w.Nonce {
+				// 放回后会重新打款，只看一次可能是节点索引没跟上，要多次确认
+				droppedAt, ok := uuc.withdrawDropped[droppedKey]
+				if !ok {
+					uuc.withdrawDropped[droppedKey] = time.Now()
+					uuc.log.Warnf("withdraw %d batch %d tx %s not found, nonce %d used at block %d, check again later", w.ID, w.BatchId, w.TxHash, w.Nonce, state.Confirmed)
+					continue
+				}
+				if time.Since(droppedAt) < uuc.chain.ReplaceAfter() {
+					continue
+				}
+
+				uuc.log.Errorf("withdraw %d batch %d tx %s dropped, nonce %d used at block %d, requeue", w.ID, w.BatchId, w.TxHash, w.Nonce, state.Confirmed)
+				err = uuc.withdrawRequeue(ctx, w)
+				if nil != err {
+					return err
+				}
+				delete(uuc.withdrawDropped, droppedKey)
+				continue
+			}
+
+			// 太久没上链自动加价，到了上限就只能重新广播并告警
+			if time.Since(txs[0].CreatedAt) > uuc.chain.ReplaceAfter() {
+				err = uuc.replaceWithdrawTx(ctx, w, txs[0], WithdrawTxCancel == txs[0].Kind)
+				if nil == err {
+					continue
+				}
+				uuc.log.Errorf("withdraw %d tx %s stuck, speed up error: %v", w.ID, txs[0].TxHash, err)
+			}
+
+			err = uuc.chain.SendRawTx(ctx, txs[0].RawTx)
+			if nil != err {
+				fmt.Println("withdraw rebroadcast error", w.ID, txs[0].TxHash, err)
+			}
+			continue
+		}
+
+		if state.Receipt.BlockNumber > state.Confirmed {
+			continue
+		}
+
+		var mined *WithdrawTx
+		for _, wt := range txs {
+			if wt.TxHash == state.TxHash {
+				mined = wt
+				break
+			}
+		}
+
+		// 取消交易上链，原转账没有发生，放回待打款
+		if WithdrawTxCancel == mined.Kind {
+			uuc.log.Warnf("withdraw %d batch %d cancelled by tx %s, requeue", w.ID, w.BatchId, mined.TxHash)
+			err = uuc.withdrawRequeue(ctx, w)
+			if nil != err {
+				return err
+			}
+			continue
+		}
+
+		status := WithdrawSuccess
+		if 1 != state.Receipt.Status {
+			status = WithdrawFailed
+			uuc.log.Errorf("withdraw %d batch %d tx %s reverted at block %d, user %d, amount %f", w.ID, w.BatchId, mined.TxHash, state.Receipt.BlockNumber, w.UserId, w.RelAmount)
+		}
+
+		err = uuc.withdrawConfirmed(ctx, w, status, mined.TxHash, state.Receipt)
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest, ca string) (*pb.AdminLoginReply, error) {
+	var (
+		admin *Admin
+		err   error
+	)
+
+	res := &pb.AdminLoginReply{}
+	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
+	fmt.Println(password)
+	admin, err = uuc.repo.GetAdminByAccount(ctx, req.SendBody.Account, password)
+	if nil != err {
+		return res, err
+	}
+
+	claims := auth.CustomClaims{
+		UserId:   uint64(admin.ID),
+		UserType: "admin",
+		RegisteredClaims: jwt2.RegisteredClaims{
+			NotBefore: jwt2.NewNumericDate(time.Now()),                     // 签名的生效时间
+			ExpiresAt: jwt2.NewNumericDate(time.Now().Add(48 * time.Hour)), // 2天过期
+			Issuer:    "game",
+		},
+	}
+
+	token, err := auth.CreateToken(claims, ca)
+	if err != nil {
+		return nil, err
+	}
+	res.Token = token
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminRewardListRequest) (*pb.AdminRewardListReply, error) {
+	var (
+		userSearch  *User
+		userId      uint64 = 0
+		userRewards []*Reward
+		users       map[uint64]*User
+		userIdsMap  map[uint64]uint64
+		userIds     []uint64
+		err         error
+		count       int64
+	)
+	res := &pb.AdminRewardListReply{
+		Rewards: make([]*pb.AdminRewardListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	userRewards, err, count = uuc.repo.GetUserRewardByUserIdPage(ctx, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Reason)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	userIdsMap = make(map[uint64]uint64, 0)
+	for _, vUserReward := range userRewards {
+		userIdsMap[vUserReward.UserId] = vUserReward.UserId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(userIds...)
+	for _, vUserReward := range userRewards {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[vUserReward.UserId]; ok {
+				tmpUser = users[vUserReward.UserId].Address
+			}
+		}
+
+		res.Rewards = append(res.Rewards, &pb.AdminRewardListReply_List{
+			CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:     fmt.Sprintf("%.2f", vUserReward.Amount),
+			Address:    tmpUser,
+			Reason:     vUserReward.Reason,
+			AddressTwo: vUserReward.Address,
+			One:        vUserReward.One,
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardTransactionList(ctx context.Context, req *pb.AdminCardTransactionListRequest) (*pb.AdminCardTransactionListReply, error) {
+	var (
+		userSearch   *User
+		userId       uint64 = 0
+		startTime    time.Time
+		endTime      time.Time
+		transactions []*CardTransaction
+		users        map[uint64]*User
+		userIds      []uint64
+		count        int64
+		err          error
+	)
+
+	res := &pb.AdminCardTransactionListReply{
+		Transactions: make([]*pb.AdminCardTransactionListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	// 日期按东八区，结束日期包含当天
+	if "" != req.StartDate {
+		startTime, err = time.ParseInLocation("2006-01-02", req.StartDate, cardIssuerLocation)
+		if nil != err {
+			return res, errors.New(500, "DATE_ERROR", "开始日期错误")
+		}
+	}
+	if "" != req.EndDate {
+		endTime, err = time.ParseInLocation("2006-01-02", req.EndDate, cardIssuerLocation)
+		if nil != err {
+			return res, errors.New(500, "DATE_ERROR", "结束日期错误")
+		}
+		endTime = endTime.Add(24 * time.Hour)
+	}
+
+	transactions, err, count = uuc.repo.GetCardTransactions(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.CardId, req.Status, startTime, endTime)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range transactions {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+
+	for _, v := range transactions {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.Transactions = append(res.Transactions, &pb.AdminCardTransactionListReply_List{
+			Id:              v.ID,
+			Address:         tmpUser,
+			CardId:          v.CardId,
+			TransactionId:   v.TransactionId,
+			TransactionType: v.TransactionType,
+			Status:          v.Status,
+			Amount:          fmt.Sprintf("%.2f", v.Amount),
+			Currency:        v.Currency,
+			MerchantName:    v.MerchantName,
+			Mcc:             v.Mcc,
+			TransactionTime: v.TransactionTime.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardBalanceDriftList(ctx context.Context, req *pb.AdminCardBalanceDriftListRequest) (*pb.AdminCardBalanceDriftListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64 = 0
+		balances   []*CardBalance
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminCardBalanceDriftListReply{
+		Balances: make([]*pb.AdminCardBalanceDriftListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	balances, err, count = uuc.repo.GetCardBalancesDrift(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range balances {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+
+	for _, v := range balances {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.Balances = append(res.Balances, &pb.AdminCardBalanceDriftListReply_List{
+			Id:        v.ID,
+			Address:   tmpUser,
+			CardId:    v.CardId,
+			Balance:   fmt.Sprintf("%.2f", v.Balance),
+			Expected:  fmt.Sprintf("%.2f", v.Expected),
+			Diff:      fmt.Sprintf("%.2f", v.Balance-v.Expected),
+			CheckedAt: v.CheckedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardTwoOrderList(ctx context.Context, req *pb.AdminCardTwoOrderListRequest) (*pb.AdminCardTwoOrderListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64 = 0
+		orders     []*CardTwoOrder
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminCardTwoOrderListReply{
+		Orders: make([]*pb.AdminCardTwoOrderListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	orders, err, count = uuc.repo.GetCardTwoOrders(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range orders {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+
+	for _, v := range orders {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.Orders = append(res.Orders, &pb.AdminCardTwoOrderListReply_List{
+			Id:         v.ID,
+			Address:    tmpUser,
+			Status:     v.Status,
+			TrackingNo: v.TrackingNo,
+			Receiver:   v.Receiver,
+			Phone:      v.Phone,
+			Country:    v.Country,
+			City:       v.City,
+			Street:     v.Street,
+			PostalCode: v.PostalCode,
+			Rewarded:   v.Rewarded,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UpdatedAt:  v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminCardTwoOrderStatus 订单流转到下一个状态，发货必须填物流单号
+func (uuc *UserUseCase) AdminCardTwoOrderStatus(ctx context.Context, req *pb.AdminCardTwoOrderStatusRequest) (*pb.AdminCardTwoOrderStatusReply, error) {
+	var (
+		order *CardTwoOrder
+		err   error
+	)
+
+	res := &pb.AdminCardTwoOrderStatusReply{}
+
+	order, err = uuc.repo.GetCardTwoOrderById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if nil == order {
+		return res, errors.New(500, "ORDER_ERROR", "订单不存在")
+	}
+
+	i := cardTwoOrderStatusIndex(order.Status)
+	if 0 > i || len(cardTwoOrderStatus)-1 <= i || cardTwoOrderStatus[i+1] != req.SendBody.Status {
+		return res, errors.New(500, "STATUS_ERROR", "订单状态错误")
+	}
+
+	if CardTwoOrderShipped == req.SendBody.Status && "" == req.SendBody.TrackingNo {
+		return res, errors.New(500, "TRACKING_ERROR", "请填写物流单号")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.UpdateCardTwoOrderStatus(ctx, order.ID, order.Status, req.SendBody.Status, req.SendBody.TrackingNo)
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// AdminCardTwoOrderAddress 发货前修改收货地址
+func (uuc *UserUseCase) AdminCardTwoOrderAddress(ctx context.Context, req *pb.AdminCardTwoOrderAddressRequest) (*pb.AdminCardTwoOrderAddressReply, error) {
+	var (
+		err error
+	)
+
+	res := &pb.AdminCardTwoOrderAddressReply{}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.UpdateCardTwoOrderAddress(ctx, &CardTwoOrder{
+			ID:         req.SendBody.Id,
+			Receiver:   req.SendBody.Receiver,
+			Phone:      req.SendBody.Phone,
+			Country:    req.SendBody.Country,
+			City:       req.SendBody.City,
+			Street:     req.SendBody.Street,
+			PostalCode: req.SendBody.PostalCode,
+		}, []string{CardTwoOrderSubmitted, CardTwoOrderApproved, CardTwoOrderProduced})
+	}); nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminUserCardHistoryList(ctx context.Context, req *pb.AdminUserCardHistoryListRequest) (*pb.AdminUserCardHistoryListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64 = 0
+		histories  []*UserCardHistory
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminUserCardHistoryListReply{
+		Histories: make([]*pb.AdminUserCardHistoryListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	histories, err, count = uuc.repo.GetUserCardHistories(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.UserCardId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range histories {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+
+	for _, v := range histories {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.Histories = append(res.Histories, &pb.AdminUserCardHistoryListReply_List{
+			Id:         v.ID,
+			UserCardId: v.UserCardId,
+			Address:    tmpUser,
+			FromStatus: v.FromStatus,
+			ToStatus:   v.ToStatus,
+			Remark:     v.Remark,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+var sensitiveEncryptLockHandle sync.Mutex
+
+// SensitiveEncryptHandle 加密存量的明文卡号和KYC字段，app 端写入的明文也由这里补加密
+func (uuc *UserUseCase) SensitiveEncryptHandle(ctx context.Context) error {
+	sensitiveEncryptLockHandle.Lock()
+	defer sensitiveEncryptLockHandle.Unlock()
+
+	count, err := uuc.repo.EncryptSensitiveFields(ctx)
+	if nil != err {
+		return err
+	}
+	if 0 < count {
+		fmt.Println("敏感字段加密，条数:", count)
+	}
+
+	return nil
+}
+
+// AdminWithdrawList 提现列表，可按地址和状态筛选
+func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64 = 0
+		withdraws  []*Withdraw
+		users      map[uint64]*User
+		userIdsMap map[uint64]uint64
+		userIds    []uint64
+		err        error
+		count      int64
+	)
+	res := &pb.AdminWithdrawListReply{
+		List: make([]*pb.AdminWithdrawListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	withdraws, err, count = uuc.repo.GetWithdraws(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	userIdsMap = make(map[uint64]uint64, 0)
+	for _, v := range withdraws {
+		userIdsMap[v.UserId] = v.UserId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(userIds...)
+	for _, v := range withdraws {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.List = append(res.List, &pb.AdminWithdrawListReply_List{
+			Id:          v.ID,
+			Address:     tmpUser,
+			Amount:      fmt.Sprintf("%.2f", v.Amount),
+			RelAmount:   fmt.Sprintf("%.2f", v.RelAmount),
+			Status:      v.Status,
+			ToAddress:   v.Address,
+			TxHash:      v.TxHash,
+			BlockNumber: v.BlockNumber,
+			AdminId:     v.AdminId,
+			Remark:      v.Remark,
+			BatchId:     v.BatchId,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UpdatedAt:   v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawAudit 人工审核，通过进入待打款，驳回退回余额并记一条流水
+func (uuc *UserUseCase) AdminWithdrawAudit(ctx context.Context, req *pb.AdminWithdrawAuditRequest, adminId uint64) (*pb.AdminWithdrawAuditReply, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	res := &pb.AdminWithdrawAuditReply{}
+	withdraw, err = uuc.repo.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if nil == withdraw {
+		return res, errors.New(500, "WITHDRAW_NOT_FOUND", "提现不存在")
+	}
+
+	canAudit := false
+	for _, v := range withdrawAuditFrom {
+		if v == withdraw.Status {
+			canAudit = true
+		}
+	}
+	if !canAudit {
+		return res, errors.New(500, "WITHDRAW_STATUS_ERROR", "提现当前状态不能审核")
+	}
+
+	switch req.SendBody.Status {
+	case WithdrawApproved:
+		err = uuc.repo.UpdateWithdrawReview(ctx, withdraw.ID, withdrawAuditFrom, WithdrawApproved, adminId, req.SendBody.Remark)
+	case WithdrawRejected:
+		if "" == req.SendBody.Remark {
+			return res, errors.New(500, "PARAM_ERROR", "请填写驳回原因")
+		}
+
+		err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return uuc.repo.RejectWithdraw(ctx, withdraw, withdrawAuditFrom, adminId, req.SendBody.Remark)
+		})
+	default:
+		return res, errors.New(500, "PARAM_ERROR", "审核状态错误")
+	}
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// AdminWithdrawReplace 已广播未上链的提现，同 nonce 加价重发或取消
+func (uuc *UserUseCase) AdminWithdrawReplace(ctx context.Context, req *pb.AdminWithdrawReplaceRequest) (*pb.AdminWithdrawReplaceReply, error) {
+	// 和确认任务互斥，避免同时替换
+	withdrawConfirmLockHandle.Lock()
+	defer withdrawConfirmLockHandle.Unlock()
+
+	var (
+		withdraw *Withdraw
+		txs      []*WithdrawTx
+		state    *ChainTxState
+		err      error
+	)
+
+	res := &pb.AdminWithdrawReplaceReply{}
+	if WithdrawTxSpeedUp != req.SendBody.Action && WithdrawTxCancel != req.SendBody.Action {
+		return res, errors.New(500, "PARAM_ERROR", "操作类型错误")
+	}
+
+	withdraw, err = uuc.repo.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	if nil == withdraw || WithdrawSent != withdraw.Status {
+		return res, errors.New(500, "WITHDRAW_STATUS_ERROR", "提现不在等待确认状态")
 	}
 
-	userOpenCard, err = uuc.repo.GetUserCardTwo()
+	txs, err = uuc.withdrawTxs(withdraw)
 	if nil != err {
-		return err
+		return res, err
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	txHashes := make([]string, 0, len(txs))
+	for _, wt := range txs {
+		txHashes = append(txHashes, wt.TxHash)
 	}
-
-	var (
-		users    []*User
-		usersMap map[uint64]*User
-	)
-	users, err = uuc.repo.GetAllUsers()
-	if nil == users {
-		fmt.Println("开卡2，用户无")
-		return nil
+	state, err = uuc.chain.WithdrawTxState(ctx, txHashes)
+	if nil != err {
+		return res, err
 	}
-
-	usersMap = make(map[uint64]*User, 0)
-	for _, vUsers := range users {
-		usersMap[vUsers.ID] = vUsers
+	if nil != state.Receipt {
+		return res, errors.New(500, "WITHDRAW_MINED", "交易已上链，等待确认")
+	}
+	// nonce 已被占用的等确认任务放回待打款，不能再替换
+	if state.Nonce > withdraw.Nonce {
+		return res, errors.New(500, "WITHDRAW_NONCE_USED", "交易nonce已被占用，等待确认任务处理")
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	// 已经取消过的只能继续加价取消
+	cancel := WithdrawTxCancel == req.SendBody.Action || WithdrawTxCancel == txs[0].Kind
+	err = uuc.replaceWithdrawTx(ctx, withdraw, txs[0], cancel)
+	if nil != err {
+		return res, errors.New(500, "WITHDRAW_REPLACE_ERROR", err.Error())
 	}
 
-	for _, userCard := range userOpenCard {
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCardTwo(ctx, userCard.ID)
-			if err != nil {
-				return err
-			}
+	withdraw, err = uuc.repo.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+	res.TxHash = withdraw.TxHash
 
-			return nil
-		}); nil != err {
-			fmt.Println("err reward 2", err, userCard)
-			continue
-		}
+	return res, nil
+}
 
-		if _, ok := usersMap[userCard.UserId]; !ok {
-			fmt.Println("开卡2，信息缺失：", userCard)
-			continue
-		}
-		user := usersMap[userCard.UserId]
+// AdminCardReveal 查看完整卡号，超级管理员重新输入密码后才返回，成功和拒绝都记录
+func (uuc *UserUseCase) AdminCardReveal(ctx context.Context, req *pb.AdminCardRevealRequest, adminId uint64) (*pb.AdminCardRevealReply, error) {
+	var (
+		admin *Admin
+		card  *UserCard
+		user  *User
+		pan   string
+		err   error
+	)
 
-		// 分红
-		var (
-			userRecommend *UserRecommend
-		)
-		tmpRecommendUserIds := make([]string, 0)
-		// 推荐
-		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
-		if nil == userRecommend {
-			fmt.Println(err, "开卡2，信息错误", err, user)
-			return nil
+	res := &pb.AdminCardRevealReply{}
+	revealLog := &CardRevealLog{
+		AdminId: adminId,
+		CardId:  req.SendBody.CardId,
+		Reason:  req.SendBody.Reason,
+	}
+	defer func() {
+		if nil != err {
+			revealLog.Result = errors.FromError(err).Message
 		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		if errTwo := uuc.repo.CreateCardRevealLog(ctx, revealLog); nil != errTwo {
+			fmt.Println("查看卡号记录失败:", errTwo, revealLog)
 		}
+	}()
 
-		tmpTopVip := uint64(3)
-		totalTmp := len(tmpRecommendUserIds) - 1
-		lastVip := uint64(0)
-		lastAmount := uint64(0)
-		for i := totalTmp; i >= 0; i-- {
-			if vipThreeThree <= lastAmount {
-				break
-			}
-
-			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-			if 0 >= tmpUserId {
-				continue
-			}
-
-			if _, ok := usersMap[tmpUserId]; !ok {
-				fmt.Println("开卡2遍历，信息缺失：", tmpUserId)
-				continue
-			}
-
-			if tmpTopVip < usersMap[tmpUserId].VipThree {
-				fmt.Println("开卡2遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
-				break
-			}
-
-			// 小于等于上一个级别，跳过
-			if usersMap[tmpUserId].VipThree <= lastVip {
-				continue
-			}
-			lastVip = usersMap[tmpUserId].VipThree
-
-			// 奖励
-			tmpAmount := uint64(0)
-			if 1 == usersMap[tmpUserId].VipThree {
-				if vipThreeOne <= lastAmount {
-					fmt.Println("开卡2遍历，vip奖励信息设置错误1：", usersMap[tmpUserId], lastVip, vipThreeOne, lastAmount)
-					continue
-				}
-
-				tmpAmount = vipThreeOne - lastAmount
-				lastAmount = vipThreeOne
-			} else if 2 == usersMap[tmpUserId].VipThree {
-				if vipThreeTwo <= lastAmount {
-					fmt.Println("开卡2遍历，vip奖励信息设置错误2：", usersMap[tmpUserId], lastVip, vipThreeTwo, lastAmount)
-					continue
-				}
-
-				tmpAmount = vipThreeTwo - lastAmount
-				lastAmount = vipThreeTwo
-			} else if 3 == usersMap[tmpUserId].VipThree {
-				if vipThreeThree <= lastAmount {
-					fmt.Println("开卡2遍历，vip奖励信息设置错误3：", usersMap[tmpUserId], lastVip, vipThreeThree, lastAmount)
-					continue
-				}
+	if "" == req.SendBody.CardId || "" == req.SendBody.Reason {
+		err = errors.New(500, "PARAM_ERROR", "请填写卡片和查看原因")
+		return res, err
+	}
 
-				tmpAmount = vipThreeThree - lastAmount
-				lastAmount = vipThreeThree
-			} else {
-				continue
-			}
+	admin, err = uuc.repo.GetAdminById(ctx, adminId)
+	if nil != err {
+		return res, err
+	}
+	if AdminTypeSuper != admin.Type || fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password))) != admin.Password {
+		err = errors.New(403, "REVEAL_DENIED", "无权限查看卡号")
+		return res, err
+	}
 
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
-				if err != nil {
-					return err
-				}
+	card, err = uuc.repo.GetUserCardByCardId(req.SendBody.CardId)
+	if nil != err {
+		return res, err
+	}
+	if nil != card {
+		revealLog.UserId = card.UserId
+		pan = card.CardNumber
+	}
 
-				return nil
-			}); nil != err {
-				fmt.Println("err reward 2", err, user, usersMap[tmpUserId])
-			}
+	// 历史数据卡号只在用户信息里
+	if "" == pan || "no" == pan {
+		user, err = uuc.repo.GetUserByCard(req.SendBody.CardId)
+		if nil != err {
+			return res, err
+		}
+		if nil != user {
+			revealLog.UserId = user.ID
+			pan = user.CardNumber
 		}
 	}
 
-	return nil
+	if "" == pan || "no" == pan {
+		err = errors.New(500, "CARD_ERROR", "卡号不存在")
+		return res, err
+	}
+
+	revealLog.Result = "ok"
+	res.CardNumber = pan
+	return res, nil
 }
 
-func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount float64) error {
+func (uuc *UserUseCase) AdminCardRevealLogList(ctx context.Context, req *pb.AdminCardRevealLogListRequest) (*pb.AdminCardRevealLogListReply, error) {
 	var (
-		err error
+		userSearch *User
+		userId     uint64 = 0
+		logs       []*CardRevealLog
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
 	)
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		err = uuc.repo.UpdateCardNo(ctx, userId, amount)
-		if err != nil {
-			return err
+
+	res := &pb.AdminCardRevealLogListReply{
+		Logs: make([]*pb.AdminCardRevealLogListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
 		}
 
-		return nil
-	}); nil != err {
-		fmt.Println("err")
-		return err
+		userId = userSearch.ID
 	}
 
-	return nil
-}
+	logs, err, count = uuc.repo.GetCardRevealLogs(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.CardId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
 
-func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
-	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
-}
+	for _, v := range logs {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
 
-func (uuc *UserUseCase) GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error) {
-	return uuc.repo.GetUserByUserIds(userIds...)
-}
+	for _, v := range logs {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
 
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "doing")
-}
+		res.Logs = append(res.Logs, &pb.AdminCardRevealLogListReply_List{
+			Id:        v.ID,
+			AdminId:   v.AdminId,
+			Address:   tmpUser,
+			CardId:    v.CardId,
+			Reason:    v.Reason,
+			Result:    v.Result,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
 
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "success")
+	return res, nil
 }
 
-func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest, ca string) (*pb.AdminLoginReply, error) {
+func (uuc *UserUseCase) AdminIssuerJournalList(ctx context.Context, req *pb.AdminIssuerJournalListRequest) (*pb.AdminIssuerJournalListReply, error) {
 	var (
-		admin *Admin
-		err   error
+		startTime time.Time
+		endTime   time.Time
+		journals  []*IssuerJournal
+		count     int64
+		err       error
 	)
 
-	res := &pb.AdminLoginReply{}
-	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
-	fmt.Println(password)
-	admin, err = uuc.repo.GetAdminByAccount(ctx, req.SendBody.Account, password)
-	if nil != err {
-		return res, err
+	res := &pb.AdminIssuerJournalListReply{
+		Journals: make([]*pb.AdminIssuerJournalListReply_List, 0),
 	}
 
-	claims := auth.CustomClaims{
-		UserId:   uint64(admin.ID),
-		UserType: "admin",
-		RegisteredClaims: jwt2.RegisteredClaims{
-			NotBefore: jwt2.NewNumericDate(time.Now()),                     // 签名的生效时间
-			ExpiresAt: jwt2.NewNumericDate(time.Now().Add(48 * time.Hour)), // 2天过期
-			Issuer:    "game",
-		},
+	// 日期按东八区，结束日期包含当天
+	if "" != req.StartDate {
+		startTime, err = time.ParseInLocation("2006-01-02", req.StartDate, cardIssuerLocation)
+		if nil != err {
+			return res, errors.New(500, "DATE_ERROR", "开始日期错误")
+		}
+	}
+	if "" != req.EndDate {
+		endTime, err = time.ParseInLocation("2006-01-02", req.EndDate, cardIssuerLocation)
+		if nil != err {
+			return res, errors.New(500, "DATE_ERROR", "结束日期错误")
+		}
+		endTime = endTime.Add(24 * time.Hour)
 	}
 
-	token, err := auth.CreateToken(claims, ca)
-	if err != nil {
-		return nil, err
+	journals, err, count = uuc.repo.GetIssuerJournals(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.Path, req.CardId, req.Reference, 1 == req.Failed, startTime, endTime)
+	if nil != err {
+		return res, nil
 	}
-	res.Token = token
+	res.Count = count
+
+	for _, v := range journals {
+		res.Journals = append(res.Journals, &pb.AdminIssuerJournalListReply_List{
+			Id:         v.ID,
+			Method:     v.Method,
+			Path:       v.Path,
+			CardId:     v.CardId,
+			Reference:  v.Reference,
+			Attempt:    v.Attempt,
+			StatusCode: v.StatusCode,
+			Duration:   v.Duration,
+			Request:    v.Request,
+			Response:   v.Response,
+			Error:      v.Error,
+			CreatedAt:  v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminRewardListRequest) (*pb.AdminRewardListReply, error) {
+func (uuc *UserUseCase) AdminUserCardList(ctx context.Context, req *pb.AdminUserCardListRequest) (*pb.AdminUserCardListReply, error) {
 	var (
-		userSearch  *User
-		userId      uint64 = 0
-		userRewards []*Reward
-		users       map[uint64]*User
-		userIdsMap  map[uint64]uint64
-		userIds     []uint64
-		err         error
-		count       int64
+		userSearch *User
+		userId     uint64 = 0
+		cards      []*UserCard
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
 	)
-	res := &pb.AdminRewardListReply{
-		Rewards: make([]*pb.AdminRewardListReply_List, 0),
+
+	res := &pb.AdminUserCardListReply{
+		Cards: make([]*pb.AdminUserCardListReply_List, 0),
 	}
 
 	// 地址查询
@@ -859,45 +3718,57 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminReward
 		userId = userSearch.ID
 	}
 
-	userRewards, err, count = uuc.repo.GetUserRewardByUserIdPage(ctx, &Pagination{
+	cards, err, count = uuc.repo.GetUserCards(&Pagination{
 		PageNum:  int(req.Page),
 		PageSize: 10,
-	}, userId, req.Reason)
+	}, userId, req.Status)
 	if nil != err {
 		return res, nil
 	}
-	res.Count = uint64(count)
+	res.Count = count
 
-	userIdsMap = make(map[uint64]uint64, 0)
-	for _, vUserReward := range userRewards {
-		userIdsMap[vUserReward.UserId] = vUserReward.UserId
-	}
-	for _, v := range userIdsMap {
-		userIds = append(userIds, v)
+	for _, v := range cards {
+		userIds = append(userIds, v.UserId)
 	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
 
-	users, err = uuc.repo.GetUserByUserIds(userIds...)
-	for _, vUserReward := range userRewards {
+	for _, v := range cards {
 		tmpUser := ""
 		if nil != users {
-			if _, ok := users[vUserReward.UserId]; ok {
-				tmpUser = users[vUserReward.UserId].Address
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
 			}
-		}
-
-		res.Rewards = append(res.Rewards, &pb.AdminRewardListReply_List{
-			CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:     fmt.Sprintf("%.2f", vUserReward.Amount),
-			Address:    tmpUser,
-			Reason:     vUserReward.Reason,
-			AddressTwo: vUserReward.Address,
-			One:        vUserReward.One,
+		}
+
+		res.Cards = append(res.Cards, &pb.AdminUserCardListReply_List{
+			Id:          v.ID,
+			UserId:      v.UserId,
+			Address:     tmpUser,
+			CardId:      v.CardId,
+			CardOrderId: v.CardOrderId,
+			CardNumber:  secure.MaskPan(v.CardNumber),
+			ProductId:   v.ProductId,
+			Status:      v.Status,
+			Main:        v.Main,
+			CreatedAt:   v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 		})
 	}
 
 	return res, nil
 }
 
+func (uuc *UserUseCase) AdminUserCardApply(ctx context.Context, req *pb.AdminUserCardApplyRequest) (*pb.AdminUserCardApplyReply, error) {
+	res := &pb.AdminUserCardApplyReply{}
+
+	card, err := uuc.CardApply(ctx, req.SendBody.UserId, req.SendBody.ProductId)
+	if nil != err {
+		return res, err
+	}
+	res.UserCardId = card.ID
+
+	return res, nil
+}
+
 func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserListRequest) (*pb.AdminUserListReply, error) {
 	var (
 		users   []*User
@@ -923,6 +3794,15 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserList
 		userIds = append(userIds, vUsers.ID)
 	}
 
+	// 开卡数
+	var (
+		cardCounts map[uint64]int64
+	)
+	cardCounts, err = uuc.repo.GetUserCardCounts(userIds...)
+	if nil != err {
+		return nil, err
+	}
+
 	// 推荐人
 	var (
 		userRecommends    []*UserRecommend
@@ -1023,17 +3903,242 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserList
 			HistoryRecommend:   lenUsers,
 			MyTotalAmount:      vUsers.MyTotalAmount,
 			Card:               vUsers.Card,
-			CardNumber:         vUsers.CardNumber,
+			CardNumber:         secure.MaskPan(vUsers.CardNumber),
 			CardOrderId:        vUsers.CardOrderId,
 			UserCount:          vUsers.UserCount,
 			VipTwo:             vUsers.VipTwo,
 			CardTwo:            vUsers.CardTwo,
+			CardUserStatus:     vUsers.CardUserStatus,
+			CardCount:          uint64(cardCounts[vUsers.ID]),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardProductList(ctx context.Context, req *pb.AdminCardProductListRequest) (*pb.AdminCardProductListReply, error) {
+	var (
+		products []*CardProductInfo
+		defaults []*CardProductDefault
+		err      error
+	)
+
+	res := &pb.AdminCardProductListReply{
+		Products: make([]*pb.AdminCardProductListReply_List, 0),
+		Defaults: make([]*pb.AdminCardProductListReply_Default, 0),
+	}
+
+	products, err = uuc.repo.GetCardProducts()
+	if nil != err {
+		return res, nil
+	}
+
+	defaults, err = uuc.repo.GetCardProductDefaults()
+	if nil != err {
+		return res, nil
+	}
+
+	for _, v := range products {
+		res.Products = append(res.Products, &pb.AdminCardProductListReply_List{
+			ProductId:     v.ProductId,
+			ProductName:   v.ProductName,
+			ModeType:      v.ModeType,
+			CardBin:       v.CardBin,
+			CardScheme:    v.CardScheme,
+			CardForm:      v.CardForm,
+			CardCurrency:  v.CardCurrency,
+			MaxCardQuota:  v.MaxCardQuota,
+			ProductStatus: v.ProductStatus,
+			Enable:        v.Enable,
+			UpdatedAt:     v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UserCardMax:   v.UserCardMax,
+		})
+	}
+
+	for _, v := range defaults {
+		res.Defaults = append(res.Defaults, &pb.AdminCardProductListReply_Default{
+			VipTwo:    v.VipTwo,
+			ProductId: v.ProductId,
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardProductEnable(ctx context.Context, req *pb.AdminCardProductEnableRequest) (*pb.AdminCardProductEnableReply, error) {
+	var (
+		enable uint64
+		err    error
+	)
+
+	res := &pb.AdminCardProductEnableReply{}
+
+	if 1 == req.SendBody.Enable {
+		enable = 1
+	} else {
+		enable = 0
+	}
+
+	err = uuc.repo.SetCardProductEnable(ctx, req.SendBody.ProductId, enable)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardProductUserCardMax(ctx context.Context, req *pb.AdminCardProductUserCardMaxRequest) (*pb.AdminCardProductUserCardMaxReply, error) {
+	res := &pb.AdminCardProductUserCardMaxReply{}
+
+	err := uuc.repo.SetCardProductUserCardMax(ctx, req.SendBody.ProductId, req.SendBody.UserCardMax)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardProductDefault(ctx context.Context, req *pb.AdminCardProductDefaultRequest) (*pb.AdminCardProductDefaultReply, error) {
+	var (
+		product *CardProductInfo
+		err     error
+	)
+
+	res := &pb.AdminCardProductDefaultReply{}
+
+	if 0 != req.SendBody.VipTwo && 30 != req.SendBody.VipTwo {
+		return res, errors.New(500, "VIP_TWO_ERROR", "区域错误")
+	}
+
+	product, err = uuc.repo.GetCardProductByProductId(req.SendBody.ProductId)
+	if nil != err {
+		return res, err
+	}
+	if nil == product || !product.Usable() {
+		return res, errors.New(500, "CARD_PRODUCT_ERROR", "产品不可用")
+	}
+
+	err = uuc.repo.SetCardProductDefault(ctx, req.SendBody.VipTwo, product.ProductId)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardRecharge(ctx context.Context, req *pb.AdminCardRechargeRequest) (*pb.AdminCardRechargeReply, error) {
+	var (
+		recharge *CardRecharge
+		err      error
+	)
+
+	res := &pb.AdminCardRechargeReply{}
+
+	recharge, err = uuc.CardRecharge(ctx, req.SendBody.UserId, req.SendBody.CardId, req.SendBody.Amount)
+	if nil != err {
+		return res, err
+	}
+	res.ReferenceCode = recharge.ReferenceCode
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardRechargeList(ctx context.Context, req *pb.AdminCardRechargeListRequest) (*pb.AdminCardRechargeListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64 = 0
+		recharges  []*CardRecharge
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminCardRechargeListReply{
+		Recharges: make([]*pb.AdminCardRechargeListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	recharges, err, count = uuc.repo.GetCardRecharges(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = count
+
+	for _, v := range recharges {
+		userIds = append(userIds, v.UserId)
+	}
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+
+	for _, v := range recharges {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.Recharges = append(res.Recharges, &pb.AdminCardRechargeListReply_List{
+			Id:            v.ID,
+			Address:       tmpUser,
+			CardId:        v.CardId,
+			Amount:        fmt.Sprintf("%.2f", v.Amount),
+			ReferenceCode: v.ReferenceCode,
+			OrderId:       v.OrderId,
+			Status:        v.Status,
+			Remark:        v.Remark,
+			CreatedAt:     v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 		})
 	}
 
 	return res, nil
 }
 
+func (uuc *UserUseCase) AdminCardFreeze(ctx context.Context, req *pb.AdminCardFreezeRequest) (*pb.AdminCardFreezeReply, error) {
+	res := &pb.AdminCardFreezeReply{}
+
+	err := uuc.CardFreeze(ctx, req.SendBody.UserId, req.SendBody.CardId, 1 == req.SendBody.Freeze)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardCancel(ctx context.Context, req *pb.AdminCardCancelRequest) (*pb.AdminCardCancelReply, error) {
+	res := &pb.AdminCardCancelReply{}
+
+	err := uuc.CardCancel(ctx, req.SendBody.UserId, req.SendBody.CardId)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardLimit(ctx context.Context, req *pb.AdminCardLimitRequest) (*pb.AdminCardLimitReply, error) {
+	res := &pb.AdminCardLimitReply{}
+
+	err := uuc.CardLimit(ctx, req.SendBody.UserId, req.SendBody.CardId, req.SendBody.DailyLimit, req.SendBody.MonthlyLimit)
+	if nil != err {
+		return res, err
+	}
+
+	return res, nil
+}
+
 func (uuc *UserUseCase) UpdateCanVip(ctx context.Context, req *pb.UpdateCanVipRequest) (*pb.UpdateCanVipReply, error) {
 	var (
 		err  error
@@ -1159,122 +4264,453 @@ type CardCreateData struct {
 	//Opt string `json:"opt"`
 }
 
-type RechargeData struct {
-	MerchantId string `json:"merchantId"`
-	//ReferenceCode string `json:"referenceCode"`
-	//Opt string `json:"opt"`
-	Remark     string `json:"remark"`
-	CardId     string `json:"cardId"`
-	CardNumber string `json:"cardNumber"`
+type RechargeData struct {
+	MerchantId    string `json:"merchantId"`
+	ReferenceCode string `json:"referenceCode"`
+	//Opt string `json:"opt"`
+	Remark     string `json:"remark"`
+	CardId     string `json:"cardId"`
+	CardNumber string `json:"cardNumber"`
+}
+
+func (uuc *UserUseCase) CallBackHandleOne(ctx context.Context, r *CardUserHandle) error {
+	fmt.Println("结果：", r)
+	var (
+		user *User
+		err  error
+	)
+	user, err = uuc.repo.GetUserByCardUserId(r.HolderId)
+	if nil != err {
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r)
+		return nil
+	}
+
+	// 和 OpenCardHandle 互斥
+	lockHandle.Lock()
+	defer lockHandle.Unlock()
+
+	return uuc.rejectCardHolder(ctx, user.ID, r.Remark)
+}
+
+func (uuc *UserUseCase) CallBackHandleTwo(ctx context.Context, r *CardCreateData) error {
+	fmt.Println("结果：", r.CardId)
+	var (
+		user *User
+		err  error
+	)
+	user, err = uuc.repo.GetUserByCard(r.CardId)
+	if nil != err {
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r.CardId)
+		return nil
+	}
+
+	err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordCardFail, r.Remark, "", "")
+	if nil != err {
+		fmt.Println("回调，新增失败", r.CardId, err)
+		return err
+	}
+
+	// 未激活的卡退款，和 CardStatusHandle 互斥
+	cardStatusLockHandle.Lock()
+	defer cardStatusLockHandle.Unlock()
+
+	card, err := uuc.repo.GetUserCardByCardId(r.CardId)
+	if nil == card || UserCardOpening != card.Status {
+		return err
+	}
+
+	backAmount := uuc.cardRefundAmount(card, user)
+	return uuc.backCard(ctx, card, backAmount, r.Remark)
+}
+
+func (uuc *UserUseCase) CallBackHandleThree(ctx context.Context, r *RechargeData) error {
+	fmt.Println("结果：", r.ReferenceCode, r.CardId)
+	var (
+		user *User
+		err  error
+	)
+	user, err = uuc.repo.GetUserByCard(r.CardId)
+	if nil != err {
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r.ReferenceCode, r.CardId)
+		return nil
+	}
+
+	err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordRechargeFail, r.Remark, r.ReferenceCode, "")
+	if nil != err {
+		fmt.Println("回调，新增失败", r.ReferenceCode, r.CardId, err)
+		return err
+	}
+
+	// 充值失败退回余额
+	return uuc.cardRechargeFail(ctx, r.ReferenceCode, r.Remark)
+}
+
+// CallBackHandleHolderSuccess 持卡人审核通过，开卡见 openHolderCards
+func (uuc *UserUseCase) CallBackHandleHolderSuccess(ctx context.Context, r *CardUserHandle) error {
+	fmt.Println("结果：", r)
+	var (
+		user *User
+		err  error
+	)
+	user, err = uuc.repo.GetUserByCardUserId(r.HolderId)
+	if nil != err {
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r)
+		return nil
+	}
+
+	err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordHolderSuccess, r.Remark, "", "")
+	if nil != err {
+		fmt.Println("回调，新增失败", r, err)
+		return err
+	}
+
+	lockHandle.Lock()
+	defer lockHandle.Unlock()
+
+	err = uuc.repo.UpdateCardUserStatus(ctx, user.ID, []string{CardHolderNone, CardHolderPending}, CardHolderActive, "")
+	if nil != err {
+		fmt.Println("持卡人状态修改失败", user.ID, err)
+	}
+
+	return nil
+}
+
+// openHolderCards 持卡人审核通过后立即开卡，失败的卡留给 OpenCardHandle 重试
+func (uuc *UserUseCase) openHolderCards(ctx context.Context, event *CardCallbackEvent) {
+	var (
+		payload        callbackPayload
+		cardholderData CardUserHandle
+		user           *User
+		cards          []*UserCard
+		err            error
+	)
+	if err = json.Unmarshal([]byte(event.Payload), &payload); nil != err {
+		return
+	}
+	if err = json.Unmarshal(payload.Data, &cardholderData); nil != err {
+		return
+	}
+
+	lockHandle.Lock()
+	defer lockHandle.Unlock()
+
+	// 加锁后重新查询，OpenCardHandle 可能已经处理
+	user, err = uuc.repo.GetUserByCardUserId(cardholderData.HolderId)
+	if nil == user || CardHolderActive != user.CardUserStatus {
+		return
+	}
+
+	cards, err = uuc.repo.GetUserCardsByUserId(user.ID, UserCardApplied)
+	if nil != err {
+		fmt.Println("回调开卡，查询卡片失败", user.ID, err)
+		return
+	}
+
+	for _, card := range cards {
+		if err = uuc.openCard(ctx, user, card); nil != err {
+			fmt.Println("回调开卡失败", user.ID, card.ID, err)
+		}
+	}
+}
+
+// CallBackHandleCardCancel 销卡结果回调，以查询到的发卡方状态和余额为准结算
+func (uuc *UserUseCase) CallBackHandleCardCancel(ctx context.Context, r *CardCreateData) error {
+	cardRechargeLockHandle.Lock()
+	defer cardRechargeLockHandle.Unlock()
+
+	card, err := uuc.repo.GetUserCardByCardId(r.CardId)
+	if nil == card || UserCardCancelling != card.Status {
+		return err
+	}
+
+	return uuc.cardCancelCheck(ctx, card)
 }
 
-func (uuc *UserUseCase) CallBackHandleOne(ctx context.Context, r *CardUserHandle) error {
-	fmt.Println("结果：", r)
+// CallBackHandleCardSuccess 卡片创建成功或激活，立即查询卡片状态并分红
+func (uuc *UserUseCase) CallBackHandleCardSuccess(ctx context.Context, r *CardCreateData) error {
+	fmt.Println("结果：", r.CardId)
 	var (
 		user *User
 		err  error
 	)
-	user, err = uuc.repo.GetUserByCardUserId(r.HolderId)
+	user, err = uuc.repo.GetUserByCard(r.CardId)
 	if nil != err {
-		fmt.Println("回调，不存在用户", r, err)
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r.CardId)
 		return nil
 	}
 
-	err = uuc.repo.InsertCardRecord(ctx, user.ID, 1, r.Remark, "", "")
+	err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordCardSuccess, r.Remark, "", "")
 	if nil != err {
-		fmt.Println("回调，新增失败", r, err)
+		fmt.Println("回调，新增失败", r.CardId, err)
+		return err
+	}
+
+	cardStatusLockHandle.Lock()
+	defer cardStatusLockHandle.Unlock()
+
+	// 加锁后重新查询，CardStatusHandle 可能已经处理
+	card, err := uuc.repo.GetUserCardByCardId(r.CardId)
+	if nil == card || UserCardOpening != card.Status {
+		return err
+	}
+
+	var (
+		users    []*User
+		usersMap map[uint64]*User
+	)
+	users, err = uuc.repo.GetAllUsers()
+	if nil == users {
+		return err
+	}
+
+	usersMap = make(map[uint64]*User, 0)
+	for _, vUsers := range users {
+		usersMap[vUsers.ID] = vUsers
+	}
+
+	if _, ok := usersMap[card.UserId]; !ok {
 		return nil
 	}
 
-	return nil
+	return uuc.cardStatus(ctx, card, usersMap[card.UserId], usersMap)
 }
 
-func (uuc *UserUseCase) CallBackHandleTwo(ctx context.Context, r *CardCreateData) error {
-	fmt.Println("结果：", r)
+// CallBackHandleRechargeSuccess 充值成功，记录
+func (uuc *UserUseCase) CallBackHandleRechargeSuccess(ctx context.Context, r *RechargeData) error {
+	fmt.Println("结果：", r.ReferenceCode, r.CardId)
 	var (
-		user *User
-		err  error
+		user     *User
+		recharge *CardRecharge
+		err      error
 	)
 	user, err = uuc.repo.GetUserByCard(r.CardId)
 	if nil != err {
-		fmt.Println("回调，不存在用户", r, err)
+		return err
+	}
+	if nil == user {
+		fmt.Println("回调，不存在用户", r.ReferenceCode, r.CardId)
 		return nil
 	}
 
-	err = uuc.repo.InsertCardRecord(ctx, user.ID, 2, r.Remark, "", "")
+	err = uuc.repo.InsertCardRecord(ctx, user.ID, CardRecordRechargeSuccess, r.Remark, r.ReferenceCode, "")
 	if nil != err {
-		fmt.Println("回调，新增失败", r, err)
+		fmt.Println("回调，新增失败", r.ReferenceCode, r.CardId, err)
+		return err
+	}
+
+	recharge, err = uuc.repo.GetCardRechargeByReferenceCode(r.ReferenceCode)
+	if nil == recharge {
+		fmt.Println("回调，不存在充值订单", r.ReferenceCode, r.CardId, err)
+		return err
+	}
+	if "success" == recharge.Status {
 		return nil
 	}
 
-	return nil
+	return uuc.repo.UpdateCardRecharge(ctx, recharge.ID, []string{"pending", "doing"}, "success", "", r.Remark)
 }
 
-func (uuc *UserUseCase) CallBackHandleThree(ctx context.Context, r *RechargeData) error {
-	fmt.Println("结果：", r)
+// CallBackEventSave 回调先落库，eventId 重复视为已收到
+func (uuc *UserUseCase) CallBackEventSave(ctx context.Context, e *CardCallbackEvent) error {
 	var (
-		user *User
-		err  error
+		created bool
+		err     error
 	)
-	user, err = uuc.repo.GetUserByCard(r.CardId)
+
+	created, err = uuc.repo.CreateCardCallbackEvent(ctx, e)
 	if nil != err {
-		fmt.Println("回调，不存在用户", r, err)
-		return nil
+		return err
 	}
 
-	err = uuc.repo.InsertCardRecord(ctx, user.ID, 3, r.Remark, "", "")
-	if nil != err {
-		fmt.Println("回调，新增失败", r, err)
-		return nil
+	if !created {
+		fmt.Println("回调，重复事件", e.EventId, e.EventType)
 	}
 
 	return nil
 }
 
-func GenerateSign(params map[string]interface{}, signKey string) string {
-	// 1. 排除 sign 字段
-	var keys []string
-	for k := range params {
-		if k != "sign" {
-			keys = append(keys, k)
+type callbackPayload struct {
+	EventType string          `json:"eventType"`
+	Data      json.RawMessage `json:"data"`
+}
+
+var callbackEventLockHandle sync.Mutex
+
+// CallbackEventHandle 处理回调收件箱，失败的事件最多重试 5 次
+func (uuc *UserUseCase) CallbackEventHandle(ctx context.Context) error {
+	callbackEventLockHandle.Lock()
+	defer callbackEventLockHandle.Unlock()
+
+	var (
+		events []*CardCallbackEvent
+		err    error
+	)
+
+	events, err = uuc.repo.GetCardCallbackEventsPending(5, 100)
+	if nil != err {
+		return err
+	}
+
+	for _, event := range events {
+		// 处理结果和 done 标记在同一事务，失败整体回滚后重试，不会重复写记录和分红
+		var status string
+		err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			status, err = uuc.callbackEventDispatch(ctx, event)
+			if nil != err {
+				return err
+			}
+
+			return uuc.repo.UpdateCardCallbackEvent(ctx, event.ID, status, "")
+		})
+		if nil != err {
+			fmt.Println("回调处理失败", event.ID, event.EventId, err)
+			if err = uuc.repo.UpdateCardCallbackEvent(ctx, event.ID, "fail", err.Error()); nil != err {
+				fmt.Println("回调状态修改失败", event.ID, err)
+			}
+			continue
+		}
+
+		// 开卡会请求发卡方，发卡方建卡后不能再随事务回滚，放到提交之后
+		if holderApprovedEvent(event.EventType) {
+			uuc.openHolderCards(ctx, event)
 		}
 	}
-	sort.Strings(keys)
 
-	// 2. 拼接 key + value 字符串
-	var sb strings.Builder
-	sb.WriteString(signKey)
+	return nil
+}
 
-	for _, k := range keys {
-		sb.WriteString(k)
-		value := params[k]
+func holderApprovedEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, "vcc.cardholder.create.suc") || strings.HasPrefix(eventType, "vcc.cardholder.approve")
+}
 
-		var strValue string
-		switch v := value.(type) {
-		case string:
-			strValue = v
-		case float64, int, int64, bool:
-			strValue = fmt.Sprintf("%v", v)
-		default:
-			// map、slice 等复杂类型用 JSON 编码
-			jsonBytes, err := json.Marshal(v)
-			if err != nil {
-				strValue = ""
-			} else {
-				strValue = string(jsonBytes)
-			}
+func (uuc *UserUseCase) callbackEventDispatch(ctx context.Context, event *CardCallbackEvent) (string, error) {
+	var (
+		payload callbackPayload
+		err     error
+	)
+	if err = json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+		return "", err
+	}
+
+	switch {
+	case strings.HasPrefix(event.EventType, "vcc.card.recharge.fai"):
+		var rechargeData RechargeData
+		if err = json.Unmarshal(payload.Data, &rechargeData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleThree(ctx, &rechargeData)
+
+	case strings.HasPrefix(event.EventType, "vcc.cardholder.create.fail"):
+		var cardholderData CardUserHandle
+		if err = json.Unmarshal(payload.Data, &cardholderData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleOne(ctx, &cardholderData)
+
+	case strings.HasPrefix(event.EventType, "vcc.card.create.fai"):
+		var createData CardCreateData
+		if err = json.Unmarshal(payload.Data, &createData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleTwo(ctx, &createData)
+
+	case strings.HasPrefix(event.EventType, "vcc.card.recharge.suc"):
+		var rechargeData RechargeData
+		if err = json.Unmarshal(payload.Data, &rechargeData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleRechargeSuccess(ctx, &rechargeData)
+
+	case holderApprovedEvent(event.EventType):
+		var cardholderData CardUserHandle
+		if err = json.Unmarshal(payload.Data, &cardholderData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleHolderSuccess(ctx, &cardholderData)
+
+	case strings.HasPrefix(event.EventType, "vcc.card.cancel"):
+		var cancelData CardCreateData
+		if err = json.Unmarshal(payload.Data, &cancelData); err != nil {
+			return "", err
 		}
-		sb.WriteString(strValue)
+		return "success", uuc.CallBackHandleCardCancel(ctx, &cancelData)
+
+	case strings.HasPrefix(event.EventType, "vcc.card.create.suc"),
+		strings.HasPrefix(event.EventType, "vcc.card.activ"):
+		var createData CardCreateData
+		if err = json.Unmarshal(payload.Data, &createData); err != nil {
+			return "", err
+		}
+		return "success", uuc.CallBackHandleCardSuccess(ctx, &createData)
+
+	default:
+		fmt.Println("Unhandled event type:", event.EventType, event.EventId)
+		return "ignore", nil
+	}
+}
+
+func (uuc *UserUseCase) AdminCallbackEventList(ctx context.Context, req *pb.AdminCallbackEventListRequest) (*pb.AdminCallbackEventListReply, error) {
+	var (
+		events []*CardCallbackEvent
+		count  int64
+		err    error
+	)
+
+	res := &pb.AdminCallbackEventListReply{
+		Events: make([]*pb.AdminCallbackEventListReply_List, 0),
+	}
+
+	events, err, count = uuc.repo.GetCardCallbackEvents(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, req.EventId, req.Status)
+	if nil != err {
+		return res, nil
 	}
+	res.Count = count
 
-	signString := sb.String()
-	//fmt.Println("md5前字符串", signString)
+	for _, v := range events {
+		res.Events = append(res.Events, &pb.AdminCallbackEventListReply_List{
+			Id:        v.ID,
+			EventId:   v.EventId,
+			EventType: v.EventType,
+			Status:    v.Status,
+			Times:     v.Times,
+			Remark:    v.Remark,
+			Payload:   string(secure.RedactJSON([]byte(v.Payload))),
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			UpdatedAt: v.UpdatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
 
-	// 3. 进行 MD5 加密
-	hash := md5.Sum([]byte(signString))
-	return hex.EncodeToString(hash[:])
+	return res, nil
 }
 
+// CardOrderNotFound 按 referenceCode 查询建卡、持卡人订单，发卡方没有该订单时返回的 code
+const CardOrderNotFound = 404
+
+// CardProvider 返回的错误用 errors.Is 判断：未发出的可以直接重试，被拒绝的是发卡方明确的结果，
+// 其余错误（超时、断连）请求可能已被处理，要按 referenceCode 查询确认，不能当作失败退款
+var (
+	ErrIssuerNotSent  = errors.New(500, "ISSUER_NOT_SENT", "发卡方请求未发出")
+	ErrIssuerRejected = errors.New(500, "ISSUER_REJECTED", "发卡方拒绝请求")
+)
+
 type CreateCardResponse struct {
 	Code int    `json:"code"`
 	Msg  string `json:"msg"`
@@ -1287,64 +4723,43 @@ type CreateCardResponse struct {
 	} `json:"data"`
 }
 
-func CreateCardRequestWithSign(cardAmount uint64, cardholderId uint64, cardProductId uint64) (*CreateCardResponse, error) {
-	//url := "https://test-api.ispay.com/dev-api/vcc/api/v1/cards/create"
-	//url := "https://www.ispay.com/prod-api/vcc/api/v1/cards/create"
-	baseUrl := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/create"
-
-	reqBody := map[string]interface{}{
-		"merchantId":    "322338",
-		"cardCurrency":  "USD",
-		"cardAmount":    cardAmount,
-		"cardholderId":  cardholderId,
-		"cardProductId": cardProductId,
-		"cardSpendRule": map[string]interface{}{
-			"dailyLimit":   250000,
-			"monthlyLimit": 1000000,
-		},
-		"cardRiskControl": map[string]interface{}{
-			"allowedMerchants": []string{"ONLINE"},
-			"blockedCountries": []string{},
-		},
-	}
-
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
-	// 请求体（包括嵌套结构）
-	reqBody["sign"] = sign
-
-	jsonData, _ := json.Marshal(reqBody)
-	req, _ := http.NewRequest("POST", baseUrl, bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Content-Language", "zh_CN")
-
-	//fmt.Println("请求报文:", string(jsonData))
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		errTwo := Body.Close()
-		if errTwo != nil {
-
-		}
-	}(resp.Body)
-
-	body, _ := ioutil.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		return nil, err
-	}
+type RechargeCardResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		OrderId       string `json:"orderId"`
+		ReferenceCode string `json:"referenceCode"`
+		Status        string `json:"status"`
+	} `json:"data"`
+}
 
-	fmt.Println("响应报文:", string(body)) // ← 打印响应内容
+type CardOperateResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		CardId     string `json:"cardId"`
+		CardStatus string `json:"cardStatus"`
+		Balance    string `json:"balance"`
+	} `json:"data"`
+}
 
-	var result CreateCardResponse
-	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("开卡，JSON 解析失败:", err)
-		return nil, err
-	}
+type CardTransactionListResponse struct {
+	Total int                   `json:"total"`
+	Rows  []CardTransactionItem `json:"rows"`
+	Code  int                   `json:"code"`
+	Msg   string                `json:"msg"`
+}
 
-	return &result, nil
+type CardTransactionItem struct {
+	TransactionId     string `json:"transactionId"`
+	CardId            string `json:"cardId"`
+	TransactionType   string `json:"transactionType"` // AUTH授权 SETTLE结算 REFUND退款 REVERSAL撤销
+	TransactionStatus string `json:"transactionStatus"`
+	Amount            string `json:"amount"`
+	Currency          string `json:"currency"`
+	MerchantName      string `json:"merchantName"`
+	Mcc               string `json:"mcc"`
+	TransactionTime   string `json:"transactionTime"`
 }
 
 type CardProductListResponse struct {
@@ -1374,60 +4789,6 @@ type AmountItem struct {
 	Currency string `json:"currency"`
 }
 
-func GetCardProducts() (*CardProductListResponse, error) {
-	baseURL := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/products/all"
-
-	reqBody := map[string]interface{}{
-		"merchantId": "322338",
-	}
-
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
-
-	params := url.Values{}
-	params.Set("merchantId", "322338")
-	params.Set("sign", sign)
-
-	fullURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
-
-	req, err := http.NewRequest("GET", fullURL, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Language", "zh_CN")
-
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		errTwo := Body.Close()
-		if errTwo != nil {
-
-		}
-	}(resp.Body)
-
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	//fmt.Println("响应报文:", string(body))
-
-	var result CardProductListResponse
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		fmt.Println("JSON 解析失败:", err)
-		return nil, err
-	}
-
-	//fmt.Println(result)
-
-	return &result, nil
-}
-
 type CreateCardholderResponse struct {
 	Code int    `json:"code"`
 	Msg  string `json:"msg"`
@@ -1456,70 +4817,6 @@ type ProofFile struct {
 	FileType   string `json:"fileType"`
 }
 
-func CreateCardholderRequest(productId uint64, user *User) (*CreateCardholderResponse, error) {
-	//baseURL := "https://www.ispay.com/prod-api/vcc/api/v1/cards/holders/create"
-	baseURL := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/holders/create"
-
-	reqBody := map[string]interface{}{
-		"productId":   productId,
-		"merchantId":  "322338",
-		"email":       user.Email,
-		"firstName":   user.FirstName,
-		"lastName":    user.LastName,
-		"birthDate":   user.BirthDate,
-		"countryCode": user.CountryCode,
-		"phoneNumber": user.Phone,
-		"deliveryAddress": map[string]interface{}{
-			"city":       user.City,
-			"country":    user.Country,
-			"street":     user.Street,
-			"postalCode": user.PostalCode,
-		},
-	}
-
-	// 生成签名
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9") // 用你的密钥替换
-	reqBody["sign"] = sign
-
-	// 构造请求
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("json marshal error: %v", err)
-	}
-
-	req, err := http.NewRequest("POST", baseURL, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("new request error: %v", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("http do error: %v", err)
-	}
-	defer func(Body io.ReadCloser) {
-		errTwo := Body.Close()
-		if errTwo != nil {
-
-		}
-	}(resp.Body)
-
-	body, _ := io.ReadAll(resp.Body)
-	fmt.Println("响应报文:", string(body))
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http status not ok: %v", resp.StatusCode)
-	}
-
-	var result CreateCardholderResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("json unmarshal error: %v", err)
-	}
-
-	return &result, nil
-}
-
 type CardInfoResponse struct {
 	Code int    `json:"code"`
 	Msg  string `json:"msg"`
@@ -1527,57 +4824,13 @@ type CardInfoResponse struct {
 		CardID     string `json:"cardId"`
 		Pan        string `json:"pan"`
 		CardStatus string `json:"cardStatus"`
+		CardAmount string `json:"cardAmount"` // 卡内余额
 		Holder     struct {
 			HolderID string `json:"holderId"`
 		} `json:"holder"`
 	} `json:"data"`
 }
 
-func GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
-	baseUrl := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/info"
-	//baseUrl := "https://www.ispay.com/prod-api/vcc/api/v1/cards/info"
-
-	reqBody := map[string]interface{}{
-		"merchantId": "322338",
-		"cardId":     cardId, // 如果需要传 cardId，根据实际接口文档添加
-	}
-
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
-	reqBody["sign"] = sign
-
-	jsonData, _ := json.Marshal(reqBody)
-	req, _ := http.NewRequest("POST", baseUrl, bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Content-Language", "zh_CN")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		errTwo := Body.Close()
-		if errTwo != nil {
-
-		}
-	}(resp.Body)
-
-	body, _ := ioutil.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("request failed: %s", string(body))
-	}
-
-	//fmt.Println("响应报文:", string(body))
-
-	var result CardInfoResponse
-	if err = json.Unmarshal(body, &result); err != nil {
-		fmt.Println("卡信息 JSON 解析失败:", err)
-		return nil, err
-	}
-
-	return &result, nil
-}
-
 type CardHolderData struct {
 	HolderId    string `json:"holderId"`
 	Email       string `json:"email"`
@@ -1595,58 +4848,3 @@ type QueryCardHolderResponse struct {
 	Msg  string         `json:"msg"`
 	Data CardHolderData `json:"data"`
 }
-
-func QueryCardHolderWithSign(holderId uint64, productId uint64) (*QueryCardHolderResponse, error) {
-	baseUrl := "https://www.ispay.com/prod-api/vcc/api/v1/cards/holders/query"
-
-	// 请求体
-	reqBody := map[string]interface{}{
-		"holderId":   holderId,
-		"merchantId": "322338",
-		"productId":  productId,
-	}
-
-	// 生成签名
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
-	reqBody["sign"] = sign
-
-	// 转 JSON
-	jsonData, _ := json.Marshal(reqBody)
-
-	// 打印调试
-	//fmt.Println("签名:", sign)
-	//fmt.Println("请求报文:", string(jsonData))
-
-	// 创建 HTTP 请求
-	req, _ := http.NewRequest("POST", baseUrl, bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Content-Language", "zh_CN")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		err := Body.Close()
-		if err != nil {
-
-		}
-	}(resp.Body)
-
-	// 读取响应
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != http.StatusOK {
-		return nil, err
-	}
-
-	//fmt.Println("响应报文:", string(body))
-
-	// 解析结果
-	var result QueryCardHolderResponse
-	if err = json.Unmarshal(body, &result); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
-}
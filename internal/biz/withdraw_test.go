@@ -0,0 +1,504 @@
+package biz_test
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data/chain"
+	"cardbinance/internal/pkg/fakechain"
+	"cardbinance/internal/pkg/fakerepo"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	walletKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	walletAddress = crypto.PubkeyToAddress(walletKey.PublicKey)
+	payee         = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	otherPayee    = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	oneGwei       = big.NewInt(1e9)
+	testLogger    = log.NewStdLogger(io.Discard)
+)
+
+func walletAlloc() core.GenesisAlloc {
+	return core.GenesisAlloc{walletAddress: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))}}
+}
+
+// newTestChain 热钱包用 walletKey 签名，确认块数 2，nodes 按顺序作为节点
+func newTestChain(t *testing.T, replaceAfter time.Duration, nodes ...*fakechain.Node) biz.ChainProvider {
+	t.Setenv("HOT_WALLET_PRIVATE_KEY", hexutil.Encode(crypto.FromECDSA(walletKey)))
+
+	urls := make([]string, 0, len(nodes))
+	nodesMap := make(map[string]*fakechain.Node, len(nodes))
+	for i, n := range nodes {
+		url := fmt.Sprintf("node%d", i)
+		urls = append(urls, url)
+		nodesMap[url] = n
+	}
+
+	c := &conf.Chain{
+		ChainId:       fakechain.ChainId,
+		Confirmations: 2,
+		Networks:      []*conf.Chain_Network{{ChainId: fakechain.ChainId, RpcUrls: urls}},
+		Signer:        &conf.Signer{Type: signer.TypeEnv},
+		Gas:           &conf.Chain_Gas{MaxGasPriceGwei: 2, ReplaceAfter: durationpb.New(replaceAfter)},
+	}
+
+	pool, cleanup, err := rpcpool.NewPoolWithDialer(c, fakechain.Dialer(nodesMap), testLogger)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+
+	s, err := signer.NewSigner(c)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return chain.NewChainProvider(c, pool, s, gas.NewPolicy(c), testLogger)
+}
+
+func signTransfer(t *testing.T, nonce uint64, to common.Address) *types.Transaction {
+	return signTransferPrice(t, nonce, to, oneGwei)
+}
+
+func signTransferPrice(t *testing.T, nonce uint64, to common.Address, gasPrice *big.Int) *types.Transaction {
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(1e18),
+	}), types.LatestSignerForChainID(big.NewInt(fakechain.ChainId)), walletKey)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func rawTx(t *testing.T, tx *types.Transaction) string {
+	raw, err := tx.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(raw)
+}
+
+// addSentWithdraw 已签名落库的提现，tx 是否广播由调用方决定
+func addSentWithdraw(t *testing.T, repo *fakerepo.Repo, id uint64, tx *types.Transaction) {
+	repo.AddWithdraw(&biz.Withdraw{
+		ID:        id,
+		UserId:    id,
+		Amount:    1,
+		RelAmount: 1,
+		Status:    biz.WithdrawSent,
+		TxHash:    tx.Hash().Hex(),
+		Nonce:     tx.Nonce(),
+		RawTx:     rawTx(t, tx),
+	})
+	if err := repo.CreateWithdrawTx(context.Background(), &biz.WithdrawTx{
+		WithdrawId: id,
+		TxHash:     tx.Hash().Hex(),
+		Nonce:      tx.Nonce(),
+		GasPrice:   tx.GasPrice().String(),
+		Kind:       biz.WithdrawTxSend,
+		RawTx:      rawTx(t, tx),
+	}); nil != err {
+		t.Fatal(err)
+	}
+}
+
+// withdrawSigner 按分配的 nonce 签一笔给 payee 的转账，签好的交易记在 signed 里
+func withdrawSigner(t *testing.T, signed map[uint64]*types.Transaction) func(nonce uint64) (*biz.WithdrawTx, error) {
+	return func(nonce uint64) (*biz.WithdrawTx, error) {
+		tx := signTransfer(t, nonce, payee)
+		signed[nonce] = tx
+		return &biz.WithdrawTx{TxHash: tx.Hash().Hex(), GasPrice: tx.GasPrice().String(), RawTx: rawTx(t, tx)}, nil
+	}
+}
+
+func balanceOf(t *testing.T, node *fakechain.Node, address common.Address) *big.Int {
+	balance, err := node.BalanceAt(context.Background(), address, nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return balance
+}
+
+// confirmTwice 确认任务跑两次，间隔超过 ReplaceAfter，丢弃判断需要多次确认
+func confirmTwice(t *testing.T, uuc *biz.UserUseCase) {
+	for i := 0; i < 2; i++ {
+		if err := uuc.WithdrawConfirmHandle(context.Background(), time.Now().UTC().Add(time.Minute)); nil != err {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// TestWithdrawConfirmLaggingNode 交易已在领先节点上链，领先节点查询中途出错换到落后节点，不能放回待打款
+func TestWithdrawConfirmLaggingNode(t *testing.T) {
+	ctx := context.Background()
+
+	tx := signTransfer(t, 0, payee)
+
+	ahead := fakechain.NewNode(walletAlloc())
+	defer ahead.Stop()
+	if err := ahead.SendTransaction(ctx, tx); nil != err {
+		t.Fatal(err)
+	}
+	ahead.Mine(4)
+	ahead.FailReceipt(true)
+
+	lagging := fakechain.NewNode(walletAlloc())
+	defer lagging.Stop()
+	lagging.Mine(1)
+
+	repo := fakerepo.New()
+	addSentWithdraw(t, repo, 1, tx)
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, 10*time.Millisecond, ahead, lagging), testLogger)
+	confirmTwice(t, uuc)
+
+	if w := repo.SavedWithdraw(1); biz.WithdrawSent != w.Status || 0 != w.Nonce {
+		t.Fatalf("withdraw = %s nonce %d, want still sent", w.Status, w.Nonce)
+	}
+
+	// 领先节点恢复后按回执确认成功
+	ahead.FailReceipt(false)
+	uuc = biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, ahead), testLogger)
+	confirmTwice(t, uuc)
+
+	if w := repo.SavedWithdraw(1); biz.WithdrawSuccess != w.Status || tx.Hash().Hex() != w.TxHash {
+		t.Fatalf("withdraw = %s %s, want success %s", w.Status, w.TxHash, tx.Hash().Hex())
+	}
+}
+
+// TestWithdrawConfirmDropped 同一节点上 nonce 被别的交易用掉且查不到提现交易，多次确认后才放回待打款
+func TestWithdrawConfirmDropped(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(walletAlloc())
+	defer node.Stop()
+
+	// 热钱包在外部用掉了 nonce 0，提现交易没有广播
+	if err := node.SendTransaction(ctx, signTransfer(t, 0, otherPayee)); nil != err {
+		t.Fatal(err)
+	}
+	node.Mine(4)
+
+	repo := fakerepo.New()
+	addSentWithdraw(t, repo, 1, signTransfer(t, 0, payee))
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, 10*time.Millisecond, node), testLogger)
+
+	// 第一次只记下，不放回
+	if err := uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawSent != w.Status {
+		t.Fatalf("withdraw = %s after first check, want sent", w.Status)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if err := uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawApproved != w.Status || "" != w.TxHash {
+		t.Fatalf("withdraw = %s %s, want approved", w.Status, w.TxHash)
+	}
+}
+
+// TestWithdrawSignNonceRestart 已签名未上链的 nonce 记在库里，重启后不会重复分配；签名失败不占用 nonce
+func TestWithdrawSignNonceRestart(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(walletAlloc())
+	defer node.Stop()
+
+	repo := fakerepo.New()
+	claimed := make(map[uint64]*biz.Withdraw, 0)
+	for id := uint64(1); id <= 3; id++ {
+		repo.AddWithdraw(&biz.Withdraw{ID: id, UserId: id, Amount: 1, RelAmount: 1, Status: biz.WithdrawApproved})
+	}
+	signed := make(map[uint64]*types.Transaction, 0)
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, node), testLogger)
+	for id := uint64(1); id <= 3; id++ {
+		w, err := uuc.UpdateWithdrawDoing(ctx, id)
+		if nil != err {
+			t.Fatal(err)
+		}
+		claimed[id] = w
+	}
+	wt, err := uuc.WithdrawSign(ctx, claimed[1], withdrawSigner(t, signed))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 0 != wt.Nonce {
+		t.Fatalf("first nonce = %d, want 0", wt.Nonce)
+	}
+
+	// 重启：新的连接和用例，链上 nonce 还是 0
+	uuc = biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, node), testLogger)
+	wt, err = uuc.WithdrawSign(ctx, claimed[2], withdrawSigner(t, signed))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != wt.Nonce {
+		t.Fatalf("nonce after restart = %d, want 1", wt.Nonce)
+	}
+
+	_, err = uuc.WithdrawSign(ctx, claimed[3], func(nonce uint64) (*biz.WithdrawTx, error) {
+		return nil, fakerepo.ErrUpdate
+	})
+	if nil == err {
+		t.Fatal("sign error not returned")
+	}
+	if w := repo.SavedWithdraw(3); biz.WithdrawDoing != w.Status {
+		t.Fatalf("withdraw 3 = %s after sign error, want doing", w.Status)
+	}
+	if nonce, _ := repo.GetWalletNonce(ctx, walletAddress.Hex()); 2 != nonce {
+		t.Fatalf("stored nonce = %d after sign error, want 2", nonce)
+	}
+
+	// 热钱包在外部用掉了 nonce 2，链上 nonce 比库里大时取链上的
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		if err = node.SendTransaction(ctx, signed[nonce]); nil != err {
+			t.Fatal(err)
+		}
+	}
+	if err = node.SendTransaction(ctx, signTransfer(t, 2, otherPayee)); nil != err {
+		t.Fatal(err)
+	}
+	node.Mine(1)
+
+	wt, err = uuc.WithdrawSign(ctx, claimed[3], withdrawSigner(t, signed))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 3 != wt.Nonce {
+		t.Fatalf("nonce after external tx = %d, want 3", wt.Nonce)
+	}
+	if w := repo.SavedWithdraw(3); biz.WithdrawSent != w.Status || 3 != w.Nonce || wt.TxHash != w.TxHash {
+		t.Fatalf("withdraw 3 = %s nonce %d %s", w.Status, w.Nonce, w.TxHash)
+	}
+}
+
+// TestWithdrawClaim 领取是条件更新，同一笔只能领一次；领取后中断的超时放回，原来那次领取不能再落交易
+func TestWithdrawClaim(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(walletAlloc())
+	defer node.Stop()
+
+	repo := fakerepo.New()
+	repo.AddWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: 1, RelAmount: 1, Status: biz.WithdrawApproved})
+	repo.AddWithdraw(&biz.Withdraw{ID: 2, UserId: 2, Amount: 1, RelAmount: 1, Status: biz.WithdrawApproved})
+	signed := make(map[uint64]*types.Transaction, 0)
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, node), testLogger)
+
+	// 没领取的不能直接置成功
+	if _, err := uuc.UpdateWithdrawSuccess(ctx, 2); nil == err {
+		t.Fatal("success from approved not rejected")
+	}
+
+	stale, err := uuc.UpdateWithdrawDoing(ctx, 1)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if _, err = uuc.UpdateWithdrawDoing(ctx, 1); nil == err {
+		t.Fatal("second claim not rejected")
+	}
+
+	// 没超时的不放回
+	if err = uuc.WithdrawDoingRequeue(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawDoing != w.Status {
+		t.Fatalf("withdraw = %s before timeout, want doing", w.Status)
+	}
+
+	// 领取后任务中断超时，放回后被重新领取
+	repo.AddWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: 1, RelAmount: 1, Status: biz.WithdrawDoing, UpdatedAt: stale.UpdatedAt.Add(-time.Hour)})
+	stale.UpdatedAt = stale.UpdatedAt.Add(-time.Hour)
+	if err = uuc.WithdrawDoingRequeue(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawApproved != w.Status {
+		t.Fatalf("withdraw = %s after timeout, want approved", w.Status)
+	}
+	claimed, err := uuc.UpdateWithdrawDoing(ctx, 1)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if _, err = uuc.WithdrawSign(ctx, stale, withdrawSigner(t, signed)); nil == err {
+		t.Fatal("stale claim signed")
+	}
+	if nonce, _ := repo.GetWalletNonce(ctx, walletAddress.Hex()); 0 != nonce {
+		t.Fatalf("stored nonce = %d after stale claim, want 0", nonce)
+	}
+
+	wt, err := uuc.WithdrawSign(ctx, claimed, withdrawSigner(t, signed))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawSent != w.Status || wt.TxHash != w.TxHash {
+		t.Fatalf("withdraw = %s %s, want sent %s", w.Status, w.TxHash, wt.TxHash)
+	}
+}
+
+// TestWithdrawConfirmPending 未上链且没超时的重新广播原交易，确认块数不够的不算成功
+func TestWithdrawConfirmPending(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(walletAlloc())
+	defer node.Stop()
+
+	// 落库后广播失败的交易，确认任务负责重新广播
+	tx := signTransfer(t, 0, payee)
+	repo := fakerepo.New()
+	addSentWithdraw(t, repo, 1, tx)
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, node), testLogger)
+	confirm := func(want string) {
+		t.Helper()
+		if err := uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+			t.Fatal(err)
+		}
+		if w := repo.SavedWithdraw(1); want != w.Status || tx.Hash().Hex() != w.TxHash {
+			t.Fatalf("withdraw = %s %s, want %s %s", w.Status, w.TxHash, want, tx.Hash().Hex())
+		}
+	}
+
+	confirm(biz.WithdrawSent)
+	node.Mine(1)
+	confirm(biz.WithdrawSent)
+	node.Mine(2)
+	confirm(biz.WithdrawSuccess)
+
+	if 0 != balanceOf(t, node, payee).Cmp(big.NewInt(1e18)) {
+		t.Fatalf("payee balance = %s, want 1e18", balanceOf(t, node, payee))
+	}
+}
+
+// TestWithdrawConfirmStuck 超过 ReplaceAfter 没上链的自动加价替换，加价超过上限的重新广播原交易
+func TestWithdrawConfirmStuck(t *testing.T) {
+	tests := []struct {
+		name         string
+		gasPrice     *big.Int
+		wantReplaced bool
+	}{
+		{name: "speed up", gasPrice: oneGwei, wantReplaced: true},
+		{name: "bump above cap rebroadcast", gasPrice: new(big.Int).Mul(big.NewInt(2), oneGwei), wantReplaced: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			node := fakechain.NewNode(walletAlloc())
+			defer node.Stop()
+
+			// 原交易没有进节点，模拟链不支持同 nonce 替换
+			tx := signTransferPrice(t, 0, payee, tt.gasPrice)
+			repo := fakerepo.New()
+			addSentWithdraw(t, repo, 1, tx)
+			repo.AgeWithdrawTxs(time.Hour)
+
+			uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Minute, node), testLogger)
+			if err := uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+				t.Fatal(err)
+			}
+
+			txs, err := repo.GetWithdrawTxs(1, 0)
+			if nil != err {
+				t.Fatal(err)
+			}
+			w := repo.SavedWithdraw(1)
+			wantHash := tx.Hash().Hex()
+			if tt.wantReplaced {
+				if 2 != len(txs) || biz.WithdrawTxSpeedUp != txs[0].Kind || 0 != txs[0].Nonce || txs[0].TxHash != w.TxHash || wantHash == w.TxHash {
+					t.Fatalf("txs = %d, last %+v, withdraw %s", len(txs), txs[0], w.TxHash)
+				}
+				wantHash = txs[0].TxHash
+			} else if 1 != len(txs) || wantHash != w.TxHash {
+				t.Fatalf("txs = %d, withdraw %s, want original %s", len(txs), w.TxHash, wantHash)
+			}
+
+			node.Mine(3)
+			if err = uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+				t.Fatal(err)
+			}
+			if w = repo.SavedWithdraw(1); biz.WithdrawSuccess != w.Status || wantHash != w.TxHash {
+				t.Fatalf("withdraw = %s %s, want success %s", w.Status, w.TxHash, wantHash)
+			}
+			if 0 != balanceOf(t, node, payee).Cmp(big.NewInt(1e18)) {
+				t.Fatalf("payee balance = %s, want 1e18", balanceOf(t, node, payee))
+			}
+		})
+	}
+}
+
+// TestAdminWithdrawReplaceCancel 取消交易上链后原转账没有发生，放回待打款；nonce 已被占用的不能再替换
+func TestAdminWithdrawReplaceCancel(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(walletAlloc())
+	defer node.Stop()
+
+	repo := fakerepo.New()
+	addSentWithdraw(t, repo, 1, signTransfer(t, 0, payee))
+
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, nil, newTestChain(t, time.Hour, node), testLogger)
+	res, err := uuc.AdminWithdrawReplace(ctx, &pb.AdminWithdrawReplaceRequest{
+		SendBody: &pb.AdminWithdrawReplaceRequest_SendBody{Id: 1, Action: biz.WithdrawTxCancel},
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	txs, err := repo.GetWithdrawTxs(1, 0)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 2 != len(txs) || biz.WithdrawTxCancel != txs[0].Kind || res.TxHash != txs[0].TxHash {
+		t.Fatalf("txs = %d, last %+v, reply %s", len(txs), txs[0], res.TxHash)
+	}
+
+	node.Mine(3)
+
+	// 取消交易已占用 nonce，不能再加价
+	_, err = uuc.AdminWithdrawReplace(ctx, &pb.AdminWithdrawReplaceRequest{
+		SendBody: &pb.AdminWithdrawReplaceRequest_SendBody{Id: 1, Action: biz.WithdrawTxSpeedUp},
+	})
+	if nil == err {
+		t.Fatal("replace after cancel mined, want error")
+	}
+
+	if err = uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(time.Minute)); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.SavedWithdraw(1); biz.WithdrawApproved != w.Status || "" != w.TxHash {
+		t.Fatalf("withdraw = %s %s, want approved", w.Status, w.TxHash)
+	}
+	if 0 != balanceOf(t, node, payee).Sign() {
+		t.Fatalf("payee balance = %s, want 0", balanceOf(t, node, payee))
+	}
+}
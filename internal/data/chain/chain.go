@@ -0,0 +1,329 @@
+package chain
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// ProviderSet is chain providers.
+var ProviderSet = wire.NewSet(rpcpool.NewPool, signer.NewSigner, gas.NewPolicy, NewChainProvider)
+
+var (
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	accountMethod = crypto.Keccak256([]byte("account()"))[:4]
+)
+
+// Client bsc 链上数据
+type Client struct {
+	c      *conf.Chain
+	pool   *rpcpool.Pool
+	signer signer.Signer
+	gas    *gas.Policy
+	log    *log.Helper
+
+	mu      sync.Mutex
+	account common.Address // 收款地址
+}
+
+func NewChainProvider(c *conf.Chain, pool *rpcpool.Pool, signer signer.Signer, gas *gas.Policy, logger log.Logger) biz.ChainProvider {
+	return &Client{
+		c:      c,
+		pool:   pool,
+		signer: signer,
+		gas:    gas,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// confirmedBlockNumber 节点最新块高减去确认块数
+func (c *Client) confirmedBlockNumber(ctx context.Context, client rpcpool.Backend) (uint64, error) {
+	confirmations := uint64(15)
+	if 0 < c.c.GetConfirmations() {
+		confirmations = c.c.GetConfirmations()
+	}
+
+	number, err := client.BlockNumber(ctx)
+	if nil != err {
+		return 0, err
+	}
+	if number <= confirmations {
+		return 0, nil
+	}
+
+	return number - confirmations, nil
+}
+
+// ConfirmedBlockNumber 最新块高减去确认块数
+func (c *Client) ConfirmedBlockNumber(ctx context.Context) (uint64, error) {
+	var number uint64
+	err := c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		var err error
+		number, err = c.confirmedBlockNumber(ctx, client)
+		return err
+	})
+
+	return number, err
+}
+
+// DepositBlockRange 首次扫描的起始块和每次扫描的块数
+func (c *Client) DepositBlockRange() (uint64, uint64) {
+	blockRange := uint64(1000)
+	if 0 < c.c.GetBlockRange() {
+		blockRange = c.c.GetBlockRange()
+	}
+
+	return c.c.GetStartBlock(), blockRange
+}
+
+// depositAccount 收款地址，未配置时读取合约 account()
+func (c *Client) depositAccount(ctx context.Context) (common.Address, error) {
+	if "" != c.c.GetDepositAccount() {
+		return common.HexToAddress(c.c.GetDepositAccount()), nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if (common.Address{}) != c.account {
+		return c.account, nil
+	}
+
+	contract := common.HexToAddress(c.c.GetDepositContract())
+	err := c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		res, err := client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: accountMethod}, nil)
+		if nil != err {
+			return err
+		}
+		if 32 != len(res) {
+			return errors.New("account() result error")
+		}
+
+		c.account = common.BytesToAddress(res[12:])
+		return nil
+	})
+
+	return c.account, err
+}
+
+// GetDeposits 区间内转入收款地址的 usdt，只保留调用充值合约的交易
+func (c *Client) GetDeposits(ctx context.Context, fromBlock uint64, toBlock uint64) ([]*biz.ChainDeposit, error) {
+	account, err := c.depositAccount(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	var logs []types.Log
+	err = c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		var errTwo error
+		logs, errTwo = client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(fromBlock),
+			ToBlock:   new(big.Int).SetUint64(toBlock),
+			Addresses: []common.Address{common.HexToAddress(c.c.GetUsdt())},
+			Topics:    [][]common.Hash{{transferTopic}, nil, {common.BytesToHash(account.Bytes())}},
+		})
+		return errTwo
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	var (
+		contract = common.HexToAddress(c.c.GetDepositContract())
+		txTo     = make(map[common.Hash]bool, 0)
+		res      = make([]*biz.ChainDeposit, 0)
+	)
+	for _, v := range logs {
+		if v.Removed || 3 != len(v.Topics) {
+			continue
+		}
+
+		if "" != c.c.GetDepositContract() {
+			if _, ok := txTo[v.TxHash]; !ok {
+				err = c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+					tx, _, errTwo := client.TransactionByHash(ctx, v.TxHash)
+					if nil != errTwo {
+						return errTwo
+					}
+
+					txTo[v.TxHash] = nil != tx.To() && contract == *tx.To()
+					return nil
+				})
+				if nil != err {
+					return nil, err
+				}
+			}
+			if !txTo[v.TxHash] {
+				continue
+			}
+		}
+
+		res = append(res, &biz.ChainDeposit{
+			TxHash:      v.TxHash.Hex(),
+			LogIndex:    uint64(v.Index),
+			BlockNumber: v.BlockNumber,
+			From:        common.BytesToAddress(v.Topics[1].Bytes()).String(),
+			Amount:      new(big.Int).SetBytes(v.Data),
+		})
+	}
+
+	return res, nil
+}
+
+// WithdrawTxState 热钱包确认块高上的 nonce 和各交易回执，全部在同一个节点上查询
+// 节点之间块高不一致，分开查询时落后节点查不到回执、领先节点 nonce 已增加，会把已上链的交易误判为丢弃；
+// 同一节点上确认块高的 nonce 已被占用而本节点查不到任何一笔回执，才是 nonce 被别的交易用掉
+func (c *Client) WithdrawTxState(ctx context.Context, txHashes []string) (*biz.ChainTxState, error) {
+	var state *biz.ChainTxState
+	err := c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		confirmed, errTwo := c.confirmedBlockNumber(ctx, client)
+		if nil != errTwo {
+			return errTwo
+		}
+
+		nonce, errTwo := client.NonceAt(ctx, c.signer.Address(), new(big.Int).SetUint64(confirmed))
+		if nil != errTwo {
+			return errTwo
+		}
+
+		res := &biz.ChainTxState{Confirmed: confirmed, Nonce: nonce}
+		for _, txHash := range txHashes {
+			receipt, errThree := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+			if errors.Is(errThree, ethereum.NotFound) {
+				continue
+			}
+			if nil != errThree {
+				return errThree
+			}
+
+			res.TxHash = txHash
+			res.Receipt = &biz.ChainReceipt{
+				Status:      receipt.Status,
+				BlockNumber: receipt.BlockNumber.Uint64(),
+				GasUsed:     receipt.GasUsed,
+			}
+			break
+		}
+
+		state = res
+		return nil
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// WalletAddress 热钱包地址
+func (c *Client) WalletAddress() string {
+	return c.signer.Address().Hex()
+}
+
+// WalletNonce 热钱包已上链的 nonce
+func (c *Client) WalletNonce(ctx context.Context) (uint64, error) {
+	var nonce uint64
+	err := c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		var errTwo error
+		nonce, errTwo = client.NonceAt(ctx, c.signer.Address(), nil)
+		return errTwo
+	})
+
+	return nonce, err
+}
+
+// SendRawTx 广播签好的交易，同一笔交易重复广播是安全的
+func (c *Client) SendRawTx(ctx context.Context, rawTx string) error {
+	raw, err := hexutil.Decode(rawTx)
+	if nil != err {
+		return err
+	}
+
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); nil != err {
+		return err
+	}
+
+	return c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+// ReplaceAfter 交易多久未上链需要加速
+func (c *Client) ReplaceAfter() time.Duration {
+	return c.gas.ReplaceAfter()
+}
+
+// ReplaceTx 同 nonce 加价重签，cancel 时改成转 0 给自己，只签名不广播
+func (c *Client) ReplaceTx(ctx context.Context, rawTx string, cancel bool) (*biz.WithdrawTx, error) {
+	raw, err := hexutil.Decode(rawTx)
+	if nil != err {
+		return nil, err
+	}
+
+	old := new(types.Transaction)
+	if err = old.UnmarshalBinary(raw); nil != err {
+		return nil, err
+	}
+
+	var suggested *big.Int
+	err = c.pool.Do(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		var errTwo error
+		suggested, errTwo = client.SuggestGasPrice(ctx)
+		return errTwo
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	gasPrice, err := c.gas.Bump(old.GasPrice(), suggested)
+	if nil != err {
+		return nil, err
+	}
+
+	replace := &types.LegacyTx{
+		Nonce:    old.Nonce(),
+		GasPrice: gasPrice,
+		Gas:      old.Gas(),
+		To:       old.To(),
+		Value:    old.Value(),
+		Data:     old.Data(),
+	}
+	if cancel {
+		to := c.signer.Address()
+		replace.To = &to
+		replace.Value = big.NewInt(0)
+		replace.Data = nil
+		replace.Gas = 21000
+	}
+
+	signed, err := c.signer.SignTx(ctx, types.NewTx(replace), new(big.Int).SetUint64(c.pool.ChainId()))
+	if nil != err {
+		return nil, err
+	}
+
+	signedRaw, err := signed.MarshalBinary()
+	if nil != err {
+		return nil, err
+	}
+
+	return &biz.WithdrawTx{
+		TxHash:   signed.Hash().Hex(),
+		Nonce:    signed.Nonce(),
+		GasPrice: gasPrice.String(),
+		RawTx:    hexutil.Encode(signedRaw),
+	}, nil
+}
@@ -0,0 +1,265 @@
+package chain
+
+import (
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/fakechain"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	testKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddress = crypto.PubkeyToAddress(testKey.PublicKey)
+	testTo      = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testGwei    = big.NewInt(1e9)
+)
+
+func testAlloc() core.GenesisAlloc {
+	return core.GenesisAlloc{testAddress: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))}}
+}
+
+// testConf nodes 按顺序作为同一条链的节点，连接池按配置顺序优先使用
+func testConf(t *testing.T, confirmations uint64, nodes ...*fakechain.Node) (*conf.Chain, rpcpool.Dialer) {
+	t.Setenv("HOT_WALLET_PRIVATE_KEY", hexutil.Encode(crypto.FromECDSA(testKey)))
+
+	urls := make([]string, 0, len(nodes))
+	nodesMap := make(map[string]*fakechain.Node, len(nodes))
+	for i, n := range nodes {
+		url := fmt.Sprintf("node%d", i)
+		urls = append(urls, url)
+		nodesMap[url] = n
+	}
+
+	return &conf.Chain{
+		ChainId:       fakechain.ChainId,
+		Confirmations: confirmations,
+		Networks:      []*conf.Chain_Network{{ChainId: fakechain.ChainId, RpcUrls: urls}},
+		Signer:        &conf.Signer{Type: signer.TypeEnv},
+		Gas:           &conf.Chain_Gas{MaxGasPriceGwei: 2},
+	}, fakechain.Dialer(nodesMap)
+}
+
+func testClient(t *testing.T, confirmations uint64, nodes ...*fakechain.Node) *Client {
+	c, dialer := testConf(t, confirmations, nodes...)
+	logger := log.NewStdLogger(io.Discard)
+
+	pool, cleanup, err := rpcpool.NewPoolWithDialer(c, dialer, logger)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+
+	s, err := signer.NewSigner(c)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return NewChainProvider(c, pool, s, gas.NewPolicy(c), logger).(*Client)
+}
+
+func testTransfer(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, gasPrice *big.Int) *types.Transaction {
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      21000,
+		To:       &testTo,
+		Value:    big.NewInt(1e18),
+	}), types.LatestSignerForChainID(big.NewInt(fakechain.ChainId)), key)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func testRaw(t *testing.T, tx *types.Transaction) string {
+	raw, err := tx.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(raw)
+}
+
+// TestWithdrawTxStateLaggingNode 领先节点已打包转账，落后节点还没有，nonce 和回执必须来自同一个节点
+func TestWithdrawTxStateLaggingNode(t *testing.T) {
+	ctx := context.Background()
+
+	ahead := fakechain.NewNode(testAlloc())
+	defer ahead.Stop()
+	lagging := fakechain.NewNode(testAlloc())
+	defer lagging.Stop()
+
+	tx := testTransfer(t, testKey, 0, testGwei)
+	if err := ahead.SendTransaction(ctx, tx); nil != err {
+		t.Fatal(err)
+	}
+	ahead.Mine(4)
+	lagging.Mine(1)
+
+	hashes := []string{tx.Hash().Hex()}
+
+	tests := []struct {
+		name        string
+		nodes       []*fakechain.Node
+		failAhead   bool
+		wantReceipt bool
+		wantNonce   uint64
+	}{
+		{name: "ahead node", nodes: []*fakechain.Node{ahead, lagging}, wantReceipt: true, wantNonce: 1},
+		{name: "lagging node", nodes: []*fakechain.Node{lagging, ahead}, wantReceipt: false, wantNonce: 0},
+		// 领先节点查完 nonce 后出错，换到落后节点要整组重查，不能拿领先节点的 nonce 配落后节点的回执
+		{name: "ahead node fails after nonce", nodes: []*fakechain.Node{ahead, lagging}, failAhead: true, wantReceipt: false, wantNonce: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ahead.FailReceipt(tt.failAhead)
+			defer ahead.FailReceipt(false)
+
+			c := testClient(t, 2, tt.nodes...)
+			state, err := c.WithdrawTxState(ctx, hashes)
+			if nil != err {
+				t.Fatal(err)
+			}
+
+			if tt.wantReceipt != (nil != state.Receipt) {
+				t.Fatalf("receipt = %v, want receipt %v", state.Receipt, tt.wantReceipt)
+			}
+			if state.Nonce != tt.wantNonce {
+				t.Fatalf("nonce = %d, want %d", state.Nonce, tt.wantNonce)
+			}
+			// 查不到回执时 nonce 不能已被占用，否则会被当成丢弃重新打款
+			if nil == state.Receipt && state.Nonce > tx.Nonce() {
+				t.Fatalf("mined tx looks dropped: nonce %d, no receipt", state.Nonce)
+			}
+			if tt.wantReceipt && (tx.Hash().Hex() != state.TxHash || 1 != state.Receipt.Status) {
+				t.Fatalf("state = %+v, receipt %+v", state, state.Receipt)
+			}
+		})
+	}
+}
+
+// TestWithdrawTxStateConfirmations 刚打包的交易有回执，但确认块高上的 nonce 还没增加
+func TestWithdrawTxStateConfirmations(t *testing.T) {
+	ctx := context.Background()
+
+	node := fakechain.NewNode(testAlloc())
+	defer node.Stop()
+
+	tx := testTransfer(t, testKey, 0, testGwei)
+	if err := node.SendTransaction(ctx, tx); nil != err {
+		t.Fatal(err)
+	}
+	node.Mine(1)
+
+	c := testClient(t, 2, node)
+	state, err := c.WithdrawTxState(ctx, []string{tx.Hash().Hex()})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if nil == state.Receipt || state.Receipt.BlockNumber <= state.Confirmed || 0 != state.Nonce {
+		t.Fatalf("state = %+v, receipt %+v", state, state.Receipt)
+	}
+
+	node.Mine(2)
+	state, err = c.WithdrawTxState(ctx, []string{tx.Hash().Hex()})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if nil == state.Receipt || state.Receipt.BlockNumber > state.Confirmed || 1 != state.Nonce {
+		t.Fatalf("state = %+v, receipt %+v", state, state.Receipt)
+	}
+}
+
+// TestReplaceTx 卡住的转账同 nonce 加价或取消，模拟链不支持交易池内替换，原交易不广播
+func TestReplaceTx(t *testing.T) {
+	tests := []struct {
+		name      string
+		gasPrice  *big.Int
+		cancel    bool
+		wantErr   error
+		wantPrice *big.Int
+		wantPaid  *big.Int // 收款地址最终余额
+	}{
+		{name: "speed up", gasPrice: testGwei, wantPrice: big.NewInt(1200000000), wantPaid: big.NewInt(1e18)},
+		{name: "cancel", gasPrice: testGwei, cancel: true, wantPrice: big.NewInt(1200000000), wantPaid: big.NewInt(0)},
+		{name: "bump above cap", gasPrice: new(big.Int).Mul(big.NewInt(2), testGwei), wantErr: gas.ErrPriceCap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			node := fakechain.NewNode(testAlloc())
+			defer node.Stop()
+			c := testClient(t, 2, node)
+
+			old := testTransfer(t, testKey, 0, tt.gasPrice)
+			wt, err := c.ReplaceTx(ctx, testRaw(t, old), tt.cancel)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if nil != tt.wantErr {
+				return
+			}
+
+			raw, err := hexutil.Decode(wt.RawTx)
+			if nil != err {
+				t.Fatal(err)
+			}
+			replace := new(types.Transaction)
+			if err = replace.UnmarshalBinary(raw); nil != err {
+				t.Fatal(err)
+			}
+
+			if old.Nonce() != replace.Nonce() || wt.Nonce != old.Nonce() || replace.Hash().Hex() != wt.TxHash {
+				t.Fatalf("replace nonce %d hash %s, wt %+v", replace.Nonce(), replace.Hash().Hex(), wt)
+			}
+			if 0 != replace.GasPrice().Cmp(tt.wantPrice) || tt.wantPrice.String() != wt.GasPrice {
+				t.Fatalf("gas price = %s, want %s", replace.GasPrice(), tt.wantPrice)
+			}
+			if tt.cancel {
+				if testAddress != *replace.To() || 0 != replace.Value().Sign() || 0 != len(replace.Data()) || 21000 != replace.Gas() {
+					t.Fatalf("cancel tx to %s value %s gas %d", replace.To(), replace.Value(), replace.Gas())
+				}
+			} else if *old.To() != *replace.To() || 0 != old.Value().Cmp(replace.Value()) || old.Gas() != replace.Gas() {
+				t.Fatalf("speed up tx to %s value %s gas %d", replace.To(), replace.Value(), replace.Gas())
+			}
+
+			if err = c.SendRawTx(ctx, wt.RawTx); nil != err {
+				t.Fatal(err)
+			}
+			node.Mine(3)
+
+			state, err := c.WithdrawTxState(ctx, []string{wt.TxHash, old.Hash().Hex()})
+			if nil != err {
+				t.Fatal(err)
+			}
+			if nil == state.Receipt || wt.TxHash != state.TxHash || 1 != state.Receipt.Status || 1 != state.Nonce {
+				t.Fatalf("state = %+v, receipt %+v", state, state.Receipt)
+			}
+
+			paid, err := node.BalanceAt(ctx, testTo, nil)
+			if nil != err {
+				t.Fatal(err)
+			}
+			if 0 != paid.Cmp(tt.wantPaid) {
+				t.Fatalf("paid = %s, want %s", paid, tt.wantPaid)
+			}
+		})
+	}
+}
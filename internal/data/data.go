@@ -3,7 +3,9 @@ package data
 import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/secure"
 	"context"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
@@ -20,8 +22,9 @@ import (
 var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo)
 
 type Data struct {
-	db  *gorm.DB
-	rdb *redis.Client
+	db     *gorm.DB
+	rdb    *redis.Client
+	cipher *secure.Cipher
 }
 
 // 用来承载事务的上下文
@@ -32,7 +35,41 @@ func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
-	return &Data{db: db, rdb: rdb}, cleanup, nil
+
+	// 卡号和 KYC 字段加密密钥
+	cipher, err := secure.NewCipher(c.GetEncryptKey())
+	if nil != err {
+		return nil, nil, err
+	}
+	if "" == c.GetEncryptKey() {
+		log.NewHelper(logger).Warn("data.encrypt_key is empty, card number and kyc fields are stored in plain text")
+	}
+
+	return &Data{db: db, rdb: rdb, cipher: cipher}, cleanup, nil
+}
+
+// encrypt 敏感字段写库前原地加密
+func (d *Data) encrypt(values ...*string) error {
+	for _, v := range values {
+		res, err := d.cipher.Encrypt(*v)
+		if nil != err {
+			return err
+		}
+		*v = res
+	}
+
+	return nil
+}
+
+// decrypt 敏感字段读库后解密，解密失败不返回密文
+func (d *Data) decrypt(value string) string {
+	res, err := d.cipher.Decrypt(value)
+	if nil != err {
+		fmt.Println("敏感字段解密失败:", err)
+		return ""
+	}
+
+	return res
 }
 
 // NewTransaction .
@@ -40,9 +77,9 @@ func NewTransaction(d *Data) biz.Transaction {
 	return d
 }
 
-// ExecTx gorm Transaction
+// ExecTx gorm Transaction，已在事务中时用 savepoint 嵌套，外层回滚时一起回滚
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return d.DB(ctx).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
@@ -61,7 +98,7 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 func NewDB(c *conf.Data) *gorm.DB {
 	f, err := os.OpenFile("../../log/sql.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
-		log.Errorf("failed opening sql.log", err)
+		log.Errorf("failed opening sql.log: %v", err)
 		panic("failed opening sql.log")
 	}
 
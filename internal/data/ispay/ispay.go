@@ -0,0 +1,339 @@
+package ispay
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProviderSet is ispay providers.
+var ProviderSet = wire.NewSet(NewCardProvider)
+
+// Client ispay 发卡渠道
+type Client struct {
+	c        *conf.CardIssuer
+	http     *http.Client
+	repo     biz.UserRepo
+	breaker  *breaker
+	maxRetry int
+	log      *log.Helper
+}
+
+func NewCardProvider(c *conf.CardIssuer, repo biz.UserRepo, logger log.Logger) biz.CardProvider {
+	timeout := 10 * time.Second
+	if nil != c.Timeout {
+		timeout = c.Timeout.AsDuration()
+	}
+
+	maxRetry := 2
+	if 0 < c.GetMaxRetry() {
+		maxRetry = int(c.GetMaxRetry())
+	}
+
+	threshold := 5
+	if 0 < c.GetBreakerThreshold() {
+		threshold = int(c.GetBreakerThreshold())
+	}
+
+	cooldown := 30 * time.Second
+	if nil != c.GetBreakerCooldown() && 0 < c.GetBreakerCooldown().AsDuration() {
+		cooldown = c.GetBreakerCooldown().AsDuration()
+	}
+
+	return &Client{
+		c: c,
+		http: &http.Client{
+			Timeout: timeout,
+		},
+		repo: repo,
+		breaker: &breaker{
+			threshold: threshold,
+			cooldown:  cooldown,
+		},
+		maxRetry: maxRetry,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (c *Client) url(path string) string {
+	return strings.TrimRight(c.c.BaseUrl, "/") + path
+}
+
+func GenerateSign(params map[string]interface{}, signKey string) string {
+	// 1. 排除 sign 字段
+	var keys []string
+	for k := range params {
+		if k != "sign" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	// 2. 拼接 key + value 字符串
+	var sb strings.Builder
+	sb.WriteString(signKey)
+
+	for _, k := range keys {
+		sb.WriteString(k)
+		value := params[k]
+
+		var strValue string
+		switch v := value.(type) {
+		case string:
+			strValue = v
+		case float64, int, int64, bool:
+			strValue = fmt.Sprintf("%v", v)
+		default:
+			// map、slice 等复杂类型用 JSON 编码
+			jsonBytes, err := json.Marshal(v)
+			if err != nil {
+				strValue = ""
+			} else {
+				strValue = string(jsonBytes)
+			}
+		}
+		sb.WriteString(strValue)
+	}
+
+	signString := sb.String()
+	//fmt.Println("md5前字符串", signString)
+
+	// 3. 进行 MD5 加密
+	hash := md5.Sum([]byte(signString))
+	return hex.EncodeToString(hash[:])
+}
+
+// CreateCard 开卡不是幂等接口，不重试，结果不确定时用 referenceCode 调 QueryCardOrder 查询
+func (c *Client) CreateCard(ctx context.Context, cardAmount uint64, cardholderId uint64, cardProductId uint64, referenceCode string) (*biz.CreateCardResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/create", nil, map[string]interface{}{
+		"referenceCode": referenceCode,
+		"cardCurrency":  "USD",
+		"cardAmount":    cardAmount,
+		"cardholderId":  cardholderId,
+		"cardProductId": cardProductId,
+		"cardSpendRule": map[string]interface{}{
+			"dailyLimit":   c.c.SpendRule.GetDailyLimit(),
+			"monthlyLimit": c.c.SpendRule.GetMonthlyLimit(),
+		},
+		"cardRiskControl": map[string]interface{}{
+			"allowedMerchants": []string{"ONLINE"},
+			"blockedCountries": []string{},
+		},
+	}, false)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CreateCardResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// QueryCardOrder 按建卡时的 referenceCode 查询建卡订单，没有订单时 code 为 biz.CardOrderNotFound
+func (c *Client) QueryCardOrder(ctx context.Context, referenceCode string) (*biz.CreateCardResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/order/query", nil, map[string]interface{}{
+		"referenceCode": referenceCode,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CreateCardResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetCardProducts(ctx context.Context) (*biz.CardProductListResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/products/all", url.Values{}, nil, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CardProductListResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// CreateCardholder 创建持卡人不是幂等接口，不重试，结果不确定时用 referenceCode 调 QueryCardHolderOrder 查询
+func (c *Client) CreateCardholder(ctx context.Context, productId uint64, user *biz.User, referenceCode string) (*biz.CreateCardholderResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/holders/create", nil, map[string]interface{}{
+		"referenceCode": referenceCode,
+		"productId":     productId,
+		"email":         user.Email,
+		"firstName":     user.FirstName,
+		"lastName":      user.LastName,
+		"birthDate":     user.BirthDate,
+		"countryCode":   user.CountryCode,
+		"phoneNumber":   user.Phone,
+		"deliveryAddress": map[string]interface{}{
+			"city":       user.City,
+			"country":    user.Country,
+			"street":     user.Street,
+			"postalCode": user.PostalCode,
+		},
+	}, false)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CreateCardholderResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// QueryCardHolderOrder 按提交持卡人时的 referenceCode 查询，没有订单时 code 为 biz.CardOrderNotFound
+func (c *Client) QueryCardHolderOrder(ctx context.Context, referenceCode string) (*biz.CreateCardholderResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/holders/order/query", nil, map[string]interface{}{
+		"referenceCode": referenceCode,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CreateCardholderResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetCardInfo(ctx context.Context, cardId string) (*biz.CardInfoResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/info", nil, map[string]interface{}{
+		"cardId": cardId,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CardInfoResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// RechargeCard referenceCode 相同的请求发卡方只处理一次，可以重试
+func (c *Client) RechargeCard(ctx context.Context, cardId string, amount float64, referenceCode string) (*biz.RechargeCardResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/recharge", nil, map[string]interface{}{
+		"cardId":        cardId,
+		"amount":        amount,
+		"currency":      "USD",
+		"referenceCode": referenceCode,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.RechargeCardResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// GetCardTransactions 按交易时间分页查询全部卡片的交易流水，时间为东八区
+func (c *Client) GetCardTransactions(ctx context.Context, startTime string, endTime string, pageNum int, pageSize int) (*biz.CardTransactionListResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/transactions", nil, map[string]interface{}{
+		"startTime": startTime,
+		"endTime":   endTime,
+		"pageNum":   pageNum,
+		"pageSize":  pageSize,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CardTransactionListResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// cardOperate 卡片操作类接口，冻结、解冻、销卡、修改限额
+func (c *Client) cardOperate(ctx context.Context, path string, reqBody map[string]interface{}, idempotent bool) (*biz.CardOperateResponse, error) {
+	body, err := c.do(ctx, path, nil, reqBody, idempotent)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.CardOperateResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
+
+func (c *Client) FreezeCard(ctx context.Context, cardId string) (*biz.CardOperateResponse, error) {
+	return c.cardOperate(ctx, "/vcc/api/v1/cards/freeze", map[string]interface{}{
+		"cardId": cardId,
+	}, true)
+}
+
+func (c *Client) UnfreezeCard(ctx context.Context, cardId string) (*biz.CardOperateResponse, error) {
+	return c.cardOperate(ctx, "/vcc/api/v1/cards/unfreeze", map[string]interface{}{
+		"cardId": cardId,
+	}, true)
+}
+
+// CancelCard 销卡会退回余额，不重试
+func (c *Client) CancelCard(ctx context.Context, cardId string) (*biz.CardOperateResponse, error) {
+	return c.cardOperate(ctx, "/vcc/api/v1/cards/cancel", map[string]interface{}{
+		"cardId": cardId,
+	}, false)
+}
+
+func (c *Client) UpdateCardLimit(ctx context.Context, cardId string, dailyLimit uint64, monthlyLimit uint64) (*biz.CardOperateResponse, error) {
+	return c.cardOperate(ctx, "/vcc/api/v1/cards/update", map[string]interface{}{
+		"cardId": cardId,
+		"cardSpendRule": map[string]interface{}{
+			"dailyLimit":   dailyLimit,
+			"monthlyLimit": monthlyLimit,
+		},
+	}, true)
+}
+
+func (c *Client) QueryCardHolder(ctx context.Context, holderId uint64, productId uint64) (*biz.QueryCardHolderResponse, error) {
+	body, err := c.do(ctx, "/vcc/api/v1/cards/holders/query", nil, map[string]interface{}{
+		"holderId":  holderId,
+		"productId": productId,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	var result biz.QueryCardHolderResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("json unmarshal error: %v", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,230 @@
+package ispay
+
+import (
+	"bytes"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/secure"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+var (
+	ErrCircuitOpen = fmt.Errorf("issuer circuit open: %w", biz.ErrIssuerNotSent)
+)
+
+// breaker 熔断器，连续失败 threshold 次后打开，cooldown 后放行一个请求试探
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if time.Since(b.openedAt) < b.cooldown || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+func (b *breaker) done(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = time.Now()
+	}
+}
+
+// retryable 网络错误、429、5xx 可以重试，其余为发卡方明确的结果
+func retryable(statusCode int, err error) bool {
+	if nil != err {
+		return true
+	}
+
+	return http.StatusTooManyRequests == statusCode || http.StatusInternalServerError <= statusCode
+}
+
+// do 发卡方统一请求入口，reqBody 为 nil 时发 GET 并把 query 签名，否则 POST json
+// idempotent 为 true 的请求遇到网络错误或 5xx 按配置重试，每次尝试都记一条日志
+func (c *Client) do(ctx context.Context, path string, query url.Values, reqBody map[string]interface{}, idempotent bool) ([]byte, error) {
+	var (
+		method   = "POST"
+		fullUrl  = c.url(path)
+		jsonData []byte
+		err      error
+	)
+
+	if nil == reqBody {
+		method = "GET"
+		params := map[string]interface{}{"merchantId": c.c.MerchantId}
+		for k := range query {
+			params[k] = query.Get(k)
+		}
+		query.Set("merchantId", c.c.MerchantId)
+		query.Set("sign", GenerateSign(params, c.c.SignKey))
+		fullUrl = fmt.Sprintf("%s?%s", fullUrl, query.Encode())
+	} else {
+		reqBody["merchantId"] = c.c.MerchantId
+		reqBody["sign"] = GenerateSign(reqBody, c.c.SignKey)
+		jsonData, err = json.Marshal(reqBody)
+		if nil != err {
+			return nil, fmt.Errorf("json marshal error: %v: %w", err, biz.ErrIssuerNotSent)
+		}
+	}
+
+	attempts := 1
+	if idempotent {
+		attempts += c.maxRetry
+	}
+
+	var (
+		body       []byte
+		statusCode int
+	)
+	for i := 1; i <= attempts; i++ {
+		if 1 < i {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(i-1) * 500 * time.Millisecond):
+			}
+		}
+
+		if !c.breaker.allow() {
+			c.journal(ctx, method, path, query, jsonData, nil, 0, i, 0, ErrCircuitOpen)
+			return nil, ErrCircuitOpen
+		}
+
+		start := time.Now()
+		body, statusCode, err = c.send(ctx, method, fullUrl, jsonData)
+		retry := retryable(statusCode, err)
+		if nil == err && http.StatusOK != statusCode {
+			err = fmt.Errorf("http status not ok: %d %s", statusCode, string(secure.RedactJSON(body)))
+			if !retry {
+				err = fmt.Errorf("%w: %v", biz.ErrIssuerRejected, err)
+			}
+		}
+
+		c.journal(ctx, method, path, query, jsonData, body, statusCode, i, time.Since(start), err)
+		c.breaker.done(!retry)
+
+		if nil == err {
+			return body, nil
+		}
+
+		if !retry {
+			return nil, err
+		}
+		c.log.Warnf("issuer request failed, path=%s, attempt=%d, err=%v", path, i, err)
+	}
+
+	return nil, err
+}
+
+func (c *Client) send(ctx context.Context, method string, fullUrl string, jsonData []byte) ([]byte, int, error) {
+	var reqBody io.Reader
+	if nil != jsonData {
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fullUrl, reqBody)
+	if nil != err {
+		return nil, 0, fmt.Errorf("new request error: %v: %w", err, biz.ErrIssuerNotSent)
+	}
+	if nil != jsonData {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Content-Language", "zh_CN")
+
+	resp, err := c.http.Do(req)
+	if nil != err {
+		return nil, 0, fmt.Errorf("http do error: %v", err)
+	}
+	defer func(Body io.ReadCloser) {
+		errTwo := Body.Close()
+		if errTwo != nil {
+
+		}
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if nil != err {
+		return nil, resp.StatusCode, fmt.Errorf("read body error: %v", err)
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+// journal 请求和响应脱敏后落库，写失败只打日志不影响业务
+func (c *Client) journal(ctx context.Context, method string, path string, query url.Values, reqData []byte, respData []byte, statusCode int, attempt int, duration time.Duration, reqErr error) {
+	if nil == c.repo {
+		return
+	}
+
+	j := &biz.IssuerJournal{
+		Method:     method,
+		Path:       path,
+		Attempt:    uint64(attempt),
+		StatusCode: uint64(statusCode),
+		Duration:   uint64(duration.Milliseconds()),
+		Request:    string(secure.RedactJSON(reqData)),
+		Response:   string(secure.RedactJSON(respData)),
+	}
+
+	if nil != query {
+		q := url.Values{}
+		for k, v := range query {
+			if secure.RedactKey(k) {
+				q.Set(k, "***")
+				continue
+			}
+			q[k] = v
+		}
+		j.Request = q.Encode()
+	}
+
+	var fields map[string]interface{}
+	if nil == json.Unmarshal(reqData, &fields) {
+		if cardId, ok := fields["cardId"].(string); ok {
+			j.CardId = cardId
+		}
+		if reference, ok := fields["referenceCode"].(string); ok {
+			j.Reference = reference
+		}
+	}
+
+	if nil != reqErr {
+		j.Error = reqErr.Error()
+	}
+
+	// 请求可能已超时取消，日志仍然要写
+	if err := c.repo.CreateIssuerJournal(context.WithoutCancel(ctx), j); nil != err {
+		c.log.Errorf("issuer journal error: %v, path=%s", err, path)
+	}
+}
@@ -0,0 +1,20 @@
+-- 发卡方回调收件箱，event_id 唯一索引保证同一事件只落库一次
+CREATE TABLE IF NOT EXISTS `card_callback_event` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `event_id` varchar(100) NOT NULL,
+  `event_type` varchar(100) NOT NULL,
+  `event_name` varchar(100) NOT NULL,
+  `source_id` varchar(100) NOT NULL,
+  `payload` text NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `times` int NOT NULL DEFAULT 0,
+  `remark` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_event_id` (`event_id`),
+  KEY `idx_status_times` (`status`, `times`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 已建表的环境补唯一索引，执行前先清理重复的 event_id
+ALTER TABLE `card_callback_event` ADD UNIQUE KEY `uk_event_id` (`event_id`);
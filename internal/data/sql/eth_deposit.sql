@@ -0,0 +1,32 @@
+-- 链上充值记录，tx_hash + log_index 唯一索引保证重扫时每笔只入账一次
+CREATE TABLE IF NOT EXISTS `eth_deposit` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `tx_hash` varchar(100) NOT NULL,
+  `log_index` int NOT NULL,
+  `block_number` bigint NOT NULL,
+  `user_id` int NOT NULL DEFAULT 0,
+  `address` varchar(100) NOT NULL,
+  `amount` varchar(100) NOT NULL,
+  `amount_two` bigint NOT NULL,
+  `status` varchar(45) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_tx_log` (`tx_hash`, `log_index`),
+  KEY `idx_eth_deposit_block_number` (`block_number`),
+  KEY `idx_eth_deposit_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 扫描游标，每个 name 一行
+CREATE TABLE IF NOT EXISTS `chain_cursor` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(45) NOT NULL,
+  `block` bigint NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_chain_cursor_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 已建表的环境补唯一索引，执行前先清理重复的 tx_hash + log_index
+ALTER TABLE `eth_deposit` ADD UNIQUE KEY `idx_tx_log` (`tx_hash`, `log_index`);
@@ -7,42 +7,44 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"time"
 )
 
 type User struct {
-	ID            uint64    `gorm:"primarykey;type:int"`
-	Address       string    `gorm:"type:varchar(100);default:'no'"`
-	Card          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardOrderId   string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardNumber    string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardAmount    float64   `gorm:"type:decimal(65,20);not null"`
-	Amount        float64   `gorm:"type:decimal(65,20)"`
-	IsDelete      uint64    `gorm:"type:int"`
-	Vip           uint64    `gorm:"type:int"`
-	MyTotalAmount uint64    `gorm:"type:bigint"`
-	AmountTwo     uint64    `gorm:"type:bigint"`
-	CardUserId    string    `gorm:"type:varchar(45);not null;default:'0'"`
-	FirstName     string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate     string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email         string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode   string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country       string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street        string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode    string    `gorm:"type:varchar(45);not null;default:'no'"`
-	MaxCardQuota  uint64    `gorm:"type:bigint"`
-	ProductId     string    `gorm:"type:varchar(45);not null;default:'0'"`
-	CreatedAt     time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
-	VipTwo        uint64    `gorm:"type:int"`
-	VipThree      uint64    `gorm:"type:int"`
-	CardTwo       uint64    `gorm:"type:int"`
-	CanVip        uint64    `gorm:"type:int"`
-	UserCount     uint64    `gorm:"type:int"`
+	ID             uint64    `gorm:"primarykey;type:int"`
+	Address        string    `gorm:"type:varchar(100);default:'no'"`
+	Card           string    `gorm:"type:varchar(100);not null;default:'no'"`
+	CardOrderId    string    `gorm:"type:varchar(100);not null;default:'no'"`
+	CardNumber     string    `gorm:"type:varchar(100);not null;default:'no'"`
+	CardAmount     float64   `gorm:"type:decimal(65,20);not null"`
+	Amount         float64   `gorm:"type:decimal(65,20)"`
+	IsDelete       uint64    `gorm:"type:int"`
+	Vip            uint64    `gorm:"type:int"`
+	MyTotalAmount  uint64    `gorm:"type:bigint"`
+	AmountTwo      uint64    `gorm:"type:bigint"`
+	CardUserId     string    `gorm:"type:varchar(45);not null;default:'0'"`
+	CardUserStatus string    `gorm:"type:varchar(45);not null;default:'none'"`
+	FirstName      string    `gorm:"type:varchar(45);not null;default:'no'"`
+	LastName       string    `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate      string    `gorm:"type:varchar(255);not null;default:'no'"`
+	Email          string    `gorm:"type:varchar(255);not null;default:'no'"`
+	CountryCode    string    `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone          string    `gorm:"type:varchar(255);not null;default:'no'"`
+	City           string    `gorm:"type:varchar(100);not null;default:'no'"`
+	Country        string    `gorm:"type:varchar(100);not null;default:'no'"`
+	Street         string    `gorm:"type:varchar(500);not null;default:'no'"`
+	PostalCode     string    `gorm:"type:varchar(45);not null;default:'no'"`
+	MaxCardQuota   uint64    `gorm:"type:bigint"`
+	ProductId      string    `gorm:"type:varchar(45);not null;default:'0'"`
+	CreatedAt      time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt      time.Time `gorm:"type:datetime;not null"`
+	VipTwo         uint64    `gorm:"type:int"`
+	VipThree       uint64    `gorm:"type:int"`
+	CardTwo        uint64    `gorm:"type:int"`
+	CanVip         uint64    `gorm:"type:int"`
+	UserCount      uint64    `gorm:"type:int"`
 }
 
 type Admin struct {
@@ -83,7 +85,7 @@ type Reward struct {
 type CardRecord struct {
 	ID         uint64    `gorm:"primarykey;type:int"`
 	UserId     uint64    `gorm:"type:int;not null"`
-	RecordType uint64    `gorm:"type:int;not null"`
+	RecordType uint64    `gorm:"type:int;not null"` // biz.CardRecordType
 	Remark     string    `gorm:"type:varchar(500);not null"`
 	Code       string    `gorm:"type:varchar(100);not null"`
 	Opt        string    `gorm:"type:varchar(100);not null"`
@@ -91,13 +93,206 @@ type CardRecord struct {
 	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
 }
 
-type Withdraw struct {
+// CardCallbackEvent 回调收件箱，event_id 唯一索引是回调去重的依据，建表见 sql/card_callback_event.sql
+type CardCallbackEvent struct {
 	ID        uint64    `gorm:"primarykey;type:int"`
-	UserId    uint64    `gorm:"type:int"`
-	Amount    float64   `gorm:"type:decimal(65,20);not null"`
-	RelAmount float64   `gorm:"type:decimal(65,20);not null"`
+	EventId   string    `gorm:"type:varchar(100);not null;uniqueIndex:uk_event_id"`
+	EventType string    `gorm:"type:varchar(100);not null"`
+	EventName string    `gorm:"type:varchar(100);not null"`
+	SourceId  string    `gorm:"type:varchar(100);not null"`
+	Payload   string    `gorm:"type:text;not null"`
 	Status    string    `gorm:"type:varchar(45);not null"`
-	Address   string    `gorm:"type:varchar(45);not null"`
+	Times     uint64    `gorm:"type:int;not null"`
+	Remark    string    `gorm:"type:varchar(500);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardProduct struct {
+	ID            uint64    `gorm:"primarykey;type:int"`
+	ProductId     string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	ProductName   string    `gorm:"type:varchar(100);not null"`
+	ModeType      string    `gorm:"type:varchar(45);not null"`
+	CardBin       string    `gorm:"type:varchar(45);not null"`
+	CardScheme    string    `gorm:"type:varchar(45);not null"`
+	CardForm      string    `gorm:"type:varchar(100);not null"`
+	CardCurrency  string    `gorm:"type:varchar(100);not null"`
+	MaxCardQuota  uint64    `gorm:"type:bigint;not null"`
+	ProductStatus string    `gorm:"type:varchar(45);not null"`
+	Enable        uint64    `gorm:"type:int;not null;default:1"`
+	UserCardMax   uint64    `gorm:"type:int;not null;default:0"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardRecharge struct {
+	ID            uint64    `gorm:"primarykey;type:int"`
+	UserId        uint64    `gorm:"type:int;not null"`
+	CardId        string    `gorm:"type:varchar(100);not null"`
+	Amount        float64   `gorm:"type:decimal(65,20);not null"`
+	ReferenceCode string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	OrderId       string    `gorm:"type:varchar(100);not null"`
+	Status        string    `gorm:"type:varchar(45);not null"`
+	Remark        string    `gorm:"type:varchar(500);not null"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardTransaction struct {
+	ID              uint64    `gorm:"primarykey;type:int"`
+	UserId          uint64    `gorm:"type:int;not null;index"`
+	CardId          string    `gorm:"type:varchar(100);not null;index"`
+	TransactionId   string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	TransactionType string    `gorm:"type:varchar(45);not null"`
+	Status          string    `gorm:"type:varchar(45);not null"`
+	Amount          float64   `gorm:"type:decimal(65,20);not null"`
+	Currency        string    `gorm:"type:varchar(45);not null"`
+	MerchantName    string    `gorm:"type:varchar(200);not null"`
+	Mcc             string    `gorm:"type:varchar(45);not null"`
+	TransactionTime time.Time `gorm:"type:datetime;not null;index"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardBalance struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	CardId    string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Balance   float64   `gorm:"type:decimal(65,20);not null"`
+	Expected  float64   `gorm:"type:decimal(65,20);not null"`
+	Drift     uint64    `gorm:"type:int;not null;index"`
+	CheckedAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserCard struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	UserId      uint64    `gorm:"type:int;not null;index"`
+	CardId      string    `gorm:"type:varchar(100);not null;index"`
+	CardOrderId string    `gorm:"type:varchar(100);not null"`
+	CardNumber  string    `gorm:"type:varchar(100);not null"`
+	ProductId   string    `gorm:"type:varchar(45);not null"`
+	Status      string    `gorm:"type:varchar(45);not null;index"` // biz.UserCardApplied 等
+	Main        uint64    `gorm:"type:int;not null;default:0"`
+	Fee         float64   `gorm:"type:decimal(65,20);not null;default:0"`
+	FeeRewardId uint64    `gorm:"type:int;not null;default:0;index"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type UserCardHistory struct {
+	ID         uint64    `gorm:"primarykey;type:int"`
+	UserCardId uint64    `gorm:"type:int;not null;index"`
+	UserId     uint64    `gorm:"type:int;not null;index"`
+	FromStatus string    `gorm:"type:varchar(45);not null"`
+	ToStatus   string    `gorm:"type:varchar(45);not null"`
+	Remark     string    `gorm:"type:varchar(500);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardRevealLog struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	AdminId   uint64    `gorm:"type:int;not null;index"`
+	UserId    uint64    `gorm:"type:int;not null;index"`
+	CardId    string    `gorm:"type:varchar(100);not null;index"`
+	Reason    string    `gorm:"type:varchar(500);not null"`
+	Result    string    `gorm:"type:varchar(200);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type IssuerJournal struct {
+	ID         uint64    `gorm:"primarykey;type:int"`
+	Method     string    `gorm:"type:varchar(10);not null"`
+	Path       string    `gorm:"type:varchar(200);not null;index"`
+	CardId     string    `gorm:"type:varchar(100);not null;index"`
+	Reference  string    `gorm:"type:varchar(100);not null;index"`
+	Attempt    uint64    `gorm:"type:int;not null"`
+	StatusCode uint64    `gorm:"type:int;not null"`
+	Duration   uint64    `gorm:"type:int;not null"`
+	Request    string    `gorm:"type:text;not null"`
+	Response   string    `gorm:"type:text;not null"`
+	Error      string    `gorm:"type:varchar(1000);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null;index"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardTwoOrder struct {
+	ID         uint64    `gorm:"primarykey;type:int"`
+	UserId     uint64    `gorm:"type:int;not null;index"`
+	RewardId   uint64    `gorm:"type:int;not null;uniqueIndex"`
+	Status     string    `gorm:"type:varchar(45);not null"`
+	TrackingNo string    `gorm:"type:varchar(100);not null"`
+	Receiver   string    `gorm:"type:varchar(200);not null"`
+	Phone      string    `gorm:"type:varchar(255);not null"`
+	Country    string    `gorm:"type:varchar(100);not null"`
+	City       string    `gorm:"type:varchar(100);not null"`
+	Street     string    `gorm:"type:varchar(500);not null"`
+	PostalCode string    `gorm:"type:varchar(45);not null"`
+	Rewarded   uint64    `gorm:"type:int;not null;default:0"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type CardProductDefault struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	VipTwo    uint64    `gorm:"type:int;not null;uniqueIndex"`
+	ProductId string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type Withdraw struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	UserId      uint64    `gorm:"type:int"`
+	Amount      float64   `gorm:"type:decimal(65,20);not null"`
+	RelAmount   float64   `gorm:"type:decimal(65,20);not null"`
+	Status      string    `gorm:"type:varchar(45);not null;index"`
+	Address     string    `gorm:"type:varchar(45);not null"`
+	TxHash      string    `gorm:"type:varchar(100);not null;default:''"`
+	Nonce       uint64    `gorm:"type:bigint;not null;default:0"`
+	RawTx       string    `gorm:"type:text"`
+	GasUsed     uint64    `gorm:"type:bigint;not null;default:0"`
+	BlockNumber uint64    `gorm:"type:bigint;not null;default:0"`
+	AdminId     uint64    `gorm:"type:int;not null;default:0"`
+	Remark      string    `gorm:"type:varchar(255);not null;default:''"`
+	BatchId     uint64    `gorm:"type:int;not null;default:0;index"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawTx struct {
+	ID         uint64    `gorm:"primarykey;type:int"`
+	WithdrawId uint64    `gorm:"type:int;not null;index"`
+	BatchId    uint64    `gorm:"type:int;not null;default:0;index"`
+	TxHash     string    `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Nonce      uint64    `gorm:"type:bigint;not null"`
+	GasPrice   string    `gorm:"type:varchar(45);not null"`
+	Kind       string    `gorm:"type:varchar(20);not null"`
+	RawTx      string    `gorm:"type:text"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawBatch struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	TxHash      string    `gorm:"type:varchar(100);not null"`
+	Nonce       uint64    `gorm:"type:bigint;not null"`
+	Count       uint64    `gorm:"type:int;not null"`
+	Amount      float64   `gorm:"type:decimal(65,20);not null"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	GasUsed     uint64    `gorm:"type:bigint;not null;default:0"`
+	BlockNumber uint64    `gorm:"type:bigint;not null;default:0"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type WalletNonce struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	Address   string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	Nonce     uint64    `gorm:"type:bigint;not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
@@ -113,6 +308,29 @@ type EthUserRecord struct {
 	Last      int64     `gorm:"type:int;not null"`
 }
 
+// EthDeposit 链上充值记录，tx_hash + log_index 唯一索引保证每笔只入账一次，建表见 sql/eth_deposit.sql
+type EthDeposit struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	TxHash      string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_tx_log"`
+	LogIndex    uint64    `gorm:"type:int;not null;uniqueIndex:idx_tx_log"`
+	BlockNumber uint64    `gorm:"type:bigint;not null;index"`
+	UserId      uint64    `gorm:"type:int;not null;index"`
+	Address     string    `gorm:"type:varchar(100);not null"`
+	Amount      string    `gorm:"type:varchar(100);not null"`
+	AmountTwo   uint64    `gorm:"type:bigint;not null"`
+	Status      string    `gorm:"type:varchar(45);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+type ChainCursor struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	Block     uint64    `gorm:"type:bigint;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserRepo struct {
 	data *Data
 	log  *log.Helper
@@ -186,7 +404,7 @@ func (u *UserRepo) GetUserByAddress(address string) (*biz.User, error) {
 		Address:       user.Address,
 		Card:          user.Card,
 		Amount:        user.Amount,
-		CardNumber:    user.CardNumber,
+		CardNumber:    u.data.decrypt(user.CardNumber),
 		CardOrderId:   user.CardOrderId,
 		CreatedAt:     user.CreatedAt,
 		UpdatedAt:     user.UpdatedAt,
@@ -204,25 +422,40 @@ func (u *UserRepo) GetUserByCardUserId(cardUserId string) (*biz.User, error) {
 	}
 
 	return &biz.User{
-		CardAmount:    user.CardAmount,
-		MyTotalAmount: user.MyTotalAmount,
-		AmountTwo:     user.AmountTwo,
-		IsDelete:      user.IsDelete,
-		Vip:           user.Vip,
-		ID:            user.ID,
-		Address:       user.Address,
-		Card:          user.Card,
-		Amount:        user.Amount,
-		CardNumber:    user.CardNumber,
-		CardOrderId:   user.CardOrderId,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
+		CardAmount:     user.CardAmount,
+		MyTotalAmount:  user.MyTotalAmount,
+		AmountTwo:      user.AmountTwo,
+		IsDelete:       user.IsDelete,
+		Vip:            user.Vip,
+		ID:             user.ID,
+		Address:        user.Address,
+		Card:           user.Card,
+		Amount:         user.Amount,
+		CardNumber:     u.data.decrypt(user.CardNumber),
+		CardOrderId:    user.CardOrderId,
+		CardUserId:     user.CardUserId,
+		CardUserStatus: user.CardUserStatus,
+		ProductId:      user.ProductId,
+		MaxCardQuota:   user.MaxCardQuota,
+		VipTwo:         user.VipTwo,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
 	}, nil
 }
 
 func (u *UserRepo) GetUserByCard(card string) (*biz.User, error) {
-	var user User
-	if err := u.data.db.Where("card=?", card).Table("user").First(&user).Error; err != nil {
+	var (
+		user     User
+		userCard UserCard
+	)
+
+	// 一个用户可以有多张卡，先按卡片表查所属用户，历史数据按 user.card 查
+	instance := u.data.db.Table("user").Where("card=?", card)
+	if err := u.data.db.Table("user_card").Where("card_id=?", card).Order("id desc").First(&userCard).Error; nil == err {
+		instance = u.data.db.Table("user").Where("id=?", userCard.UserId)
+	}
+
+	if err := instance.First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -231,19 +464,24 @@ func (u *UserRepo) GetUserByCard(card string) (*biz.User, error) {
 	}
 
 	return &biz.User{
-		CardAmount:    user.CardAmount,
-		MyTotalAmount: user.MyTotalAmount,
-		AmountTwo:     user.AmountTwo,
-		IsDelete:      user.IsDelete,
-		Vip:           user.Vip,
-		ID:            user.ID,
-		Address:       user.Address,
-		Card:          user.Card,
-		Amount:        user.Amount,
-		CardNumber:    user.CardNumber,
-		CardOrderId:   user.CardOrderId,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
+		CardAmount:     user.CardAmount,
+		MyTotalAmount:  user.MyTotalAmount,
+		AmountTwo:      user.AmountTwo,
+		IsDelete:       user.IsDelete,
+		Vip:            user.Vip,
+		ID:             user.ID,
+		Address:        user.Address,
+		Card:           user.Card,
+		Amount:         user.Amount,
+		CardNumber:     u.data.decrypt(user.CardNumber),
+		CardOrderId:    user.CardOrderId,
+		CardUserId:     user.CardUserId,
+		CardUserStatus: user.CardUserStatus,
+		ProductId:      user.ProductId,
+		MaxCardQuota:   user.MaxCardQuota,
+		VipTwo:         user.VipTwo,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
 	}, nil
 }
 
@@ -258,19 +496,36 @@ func (u *UserRepo) GetUserById(userId uint64) (*biz.User, error) {
 	}
 
 	return &biz.User{
-		CardAmount:    user.CardAmount,
-		MyTotalAmount: user.MyTotalAmount,
-		AmountTwo:     user.AmountTwo,
-		IsDelete:      user.IsDelete,
-		Vip:           user.Vip,
-		ID:            user.ID,
-		Address:       user.Address,
-		Card:          user.Card,
-		Amount:        user.Amount,
-		CardNumber:    user.CardNumber,
-		CardOrderId:   user.CardOrderId,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
+		CardAmount:     user.CardAmount,
+		MyTotalAmount:  user.MyTotalAmount,
+		AmountTwo:      user.AmountTwo,
+		IsDelete:       user.IsDelete,
+		Vip:            user.Vip,
+		ID:             user.ID,
+		Address:        user.Address,
+		Card:           user.Card,
+		Amount:         user.Amount,
+		CardNumber:     u.data.decrypt(user.CardNumber),
+		CardOrderId:    user.CardOrderId,
+		CardUserId:     user.CardUserId,
+		CardUserStatus: user.CardUserStatus,
+		ProductId:      user.ProductId,
+		MaxCardQuota:   user.MaxCardQuota,
+		VipTwo:         user.VipTwo,
+		VipThree:       user.VipThree,
+		CardTwo:        user.CardTwo,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		BirthDate:      u.data.decrypt(user.BirthDate),
+		Email:          u.data.decrypt(user.Email),
+		CountryCode:    user.CountryCode,
+		Phone:          u.data.decrypt(user.Phone),
+		City:           user.City,
+		Country:        user.Country,
+		Street:         u.data.decrypt(user.Street),
+		PostalCode:     user.PostalCode,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
 	}, nil
 }
 
@@ -319,7 +574,7 @@ func (u *UserRepo) CreateUser(ctx context.Context, uc *biz.User) (*biz.User, err
 		Amount:        user.Amount,
 		CreatedAt:     user.CreatedAt,
 		UpdatedAt:     user.UpdatedAt,
-		CardNumber:    user.CardNumber,
+		CardNumber:    u.data.decrypt(user.CardNumber),
 		CardOrderId:   user.CardOrderId,
 	}, nil
 }
@@ -404,25 +659,23 @@ func (u *UserRepo) GetUserRecommendLikeCode(code string) ([]*biz.UserRecommend,
 	return res, nil
 }
 
-// UpdateWithdraw .
-func (u *UserRepo) UpdateWithdraw(ctx context.Context, id uint64, status string) (*biz.Withdraw, error) {
-	var withdraw Withdraw
-	withdraw.Status = status
-	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Updates(&withdraw)
+// UpdateWithdraw 只有状态在 from 里的才改，没改到说明已被其他任务领走
+// 返回的 UpdatedAt 是这次领取的时间，后续落交易时用来确认还是自己领的那一次
+func (u *UserRepo) UpdateWithdraw(ctx context.Context, id uint64, from []string, status string) (*biz.Withdraw, error) {
+	now := time.Now().Truncate(time.Second)
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status in (?)", from).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": now.Format("2006-01-02 15:04:05"),
+		})
 	if res.Error != nil {
-		return nil, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录修改失败")
+		return nil, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+	if 0 >= res.RowsAffected {
+		return nil, errors.New(500, "WITHDRAW_STATUS_ERROR", "提现状态已变化")
 	}
 
-	return &biz.Withdraw{
-		ID:        withdraw.ID,
-		UserId:    withdraw.UserId,
-		Amount:    withdraw.Amount,
-		RelAmount: withdraw.RelAmount,
-		Status:    withdraw.Status,
-		Address:   withdraw.Address,
-		CreatedAt: withdraw.CreatedAt,
-		UpdatedAt: withdraw.UpdatedAt,
-	}, nil
+	return &biz.Withdraw{ID: id, Status: status, UpdatedAt: now}, nil
 }
 
 // GetUserByUserIds .
@@ -451,7 +704,7 @@ func (u *UserRepo) GetUserByUserIds(userIds ...uint64) (map[uint64]*biz.User, er
 			Amount:        user.Amount,
 			CreatedAt:     user.CreatedAt,
 			UpdatedAt:     user.UpdatedAt,
-			CardNumber:    user.CardNumber,
+			CardNumber:    u.data.decrypt(user.CardNumber),
 			CardOrderId:   user.CardOrderId,
 		}
 	}
@@ -485,19 +738,24 @@ func (u *UserRepo) GetConfigByKeys(keys ...string) ([]*biz.Config, error) {
 
 // CreateCard .
 func (u *UserRepo) CreateCard(ctx context.Context, userId uint64, user *biz.User) error {
+	birthDate, email, phone, street := user.BirthDate, user.Email, user.Phone, user.Street
+	if err := u.data.encrypt(&birthDate, &email, &phone, &street); nil != err {
+		return errors.New(500, "ENCRYPT_ERROR", "用户信息加密失败")
+	}
+
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).Where("card_order_id=?", "no").
 		Updates(map[string]interface{}{
 			"amount":        gorm.Expr("amount - ?", user.Amount),
 			"card_order_id": "do",
 			"first_name":    user.FirstName,
 			"last_name":     user.LastName,
-			"birth_date":    user.BirthDate,
-			"email":         user.Email,
-			"phone":         user.Phone,
+			"birth_date":    birthDate,
+			"email":         email,
+			"phone":         phone,
 			"country_code":  user.CountryCode,
 			"country":       user.Country,
 			"city":          user.City,
-			"street":        user.Street,
+			"street":        street,
 			"postal_code":   user.PostalCode,
 			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
 		})
@@ -551,7 +809,8 @@ func (u *UserRepo) UpdateCard(ctx context.Context, userId uint64, cardOrderId, c
 
 // UpdateCardNo .
 func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+	// 只退开卡中、未激活的，回调和轮询不会重复退款
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("card_order_id!=?", "no").Where("card_number=?", "no").
 		Updates(map[string]interface{}{
 			"card_order_id": "no",
 			"card":          "no",
@@ -567,7 +826,7 @@ func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float
 	)
 
 	reward.UserId = userId
-	reward.Amount = 10
+	reward.Amount = amount
 	reward.Reason = 7 // 给我分红的理由
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
@@ -577,9 +836,48 @@ func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float
 	return nil
 }
 
+// UpdateCardUserSubmitted 提交持卡人资料，审核通过前 card_user_id 存提交的 referenceCode
+func (u *UserRepo) UpdateCardUserSubmitted(ctx context.Context, userId uint64, productId string, maxCardQuota uint64, referenceCode string) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("card_user_status IN (?)", []string{biz.CardHolderNone, biz.CardHolderRejected}).
+		Updates(map[string]interface{}{
+			"card_user_status": biz.CardHolderSubmitted,
+			"card_user_id":     referenceCode,
+			"product_id":       productId,
+			"max_card_quota":   maxCardQuota,
+			"updated_at":       time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
+// UpdateCardUserStatus 持卡人状态只能从 from 中的状态修改
+func (u *UserRepo) UpdateCardUserStatus(ctx context.Context, userId uint64, from []string, status string, cardUserId string) error {
+	updates := map[string]interface{}{
+		"card_user_status": status,
+		"updated_at":       time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if "" != cardUserId {
+		updates["card_user_id"] = cardUserId
+	}
+
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("card_user_status IN (?)", from).Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
 // UpdateCardSuccess .
 func (u *UserRepo) UpdateCardSucces(ctx context.Context, userId uint64, cardNum string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+	if err := u.data.encrypt(&cardNum); nil != err {
+		return errors.New(500, "ENCRYPT_ERROR", "卡号加密失败")
+	}
+
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("card_number=?", "no").
 		Updates(map[string]interface{}{
 			"card_number": cardNum,
 			"updated_at":  time.Now().Format("2006-01-02 15:04:05"),
@@ -616,7 +914,7 @@ func (u *UserRepo) GetAllUsers() ([]*biz.User, error) {
 			Amount:        user.Amount,
 			CreatedAt:     user.CreatedAt,
 			UpdatedAt:     user.UpdatedAt,
-			CardNumber:    user.CardNumber,
+			CardNumber:    u.data.decrypt(user.CardNumber),
 			CardOrderId:   user.CardOrderId,
 			VipTwo:        user.VipTwo,
 			VipThree:      user.VipThree,
@@ -648,146 +946,480 @@ func (u *UserRepo) GetUserRecommends() ([]*biz.UserRecommend, error) {
 	return res, nil
 }
 
-// GetUsersOpenCard .
-func (u *UserRepo) GetUsersOpenCard() ([]*biz.User, error) {
-	var users []*User
-
-	res := make([]*biz.User, 0)
-	if err := u.data.db.Table("user").Where("card_order_id=?", "do").Order("id asc").Find(&users).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, nil
-		}
+func toBizWithdraw(withdraw *Withdraw) *biz.Withdraw {
+	return &biz.Withdraw{
+		ID:          withdraw.ID,
+		UserId:      withdraw.UserId,
+		Amount:      withdraw.Amount,
+		RelAmount:   withdraw.RelAmount,
+		Status:      withdraw.Status,
+		Address:     withdraw.Address,
+		TxHash:      withdraw.TxHash,
+		Nonce:       withdraw.Nonce,
+		RawTx:       withdraw.RawTx,
+		GasUsed:     withdraw.GasUsed,
+		BlockNumber: withdraw.BlockNumber,
+		AdminId:     withdraw.AdminId,
+		Remark:      withdraw.Remark,
+		BatchId:     withdraw.BatchId,
+		CreatedAt:   withdraw.CreatedAt,
+		UpdatedAt:   withdraw.UpdatedAt,
+	}
+}
 
-		return nil, errors.New(500, "USER ERROR", err.Error())
+// UpdateWithdrawSent 打款中的提现记下签好的交易，claimedAt 是领取时写入的 updated_at，
+// 领取超时被放回又被别的任务领走时对不上，不会覆盖
+func (u *UserRepo) UpdateWithdrawSent(ctx context.Context, id uint64, claimedAt time.Time, txHash string, nonce uint64, rawTx string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", biz.WithdrawDoing).
+		Where("tx_hash=?", "").Where("updated_at=?", claimedAt.Format("2006-01-02 15:04:05")).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawSent,
+			"tx_hash":    txHash,
+			"nonce":      nonce,
+			"raw_tx":     rawTx,
+			"batch_id":   0,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
 	}
 
-	for _, user := range users {
-		res = append(res, &biz.User{
-			CardAmount:    user.CardAmount,
-			MyTotalAmount: user.MyTotalAmount,
-			AmountTwo:     user.AmountTwo,
-			IsDelete:      user.IsDelete,
-			Vip:           user.Vip,
-			ID:            user.ID,
-			Address:       user.Address,
-			Card:          user.Card,
-			Amount:        user.Amount,
-			CreatedAt:     user.CreatedAt,
-			UpdatedAt:     user.UpdatedAt,
-			CardNumber:    user.CardNumber,
-			CardOrderId:   user.CardOrderId,
-			FirstName:     user.FirstName,
-			LastName:      user.LastName,
-			BirthDate:     user.BirthDate,
-			Email:         user.Email,
-			CountryCode:   user.CountryCode,
-			Phone:         user.Phone,
-			City:          user.City,
-			Country:       user.Country,
-			Street:        user.Street,
-			PostalCode:    user.PostalCode,
-			CardUserId:    user.CardUserId,
-			MaxCardQuota:  user.MaxCardQuota,
-			ProductId:     user.ProductId,
-			VipTwo:        user.VipTwo,
+	return nil
+}
+
+// UpdateWithdrawDoingTimeout 领取后超时还没落交易的放回待打款，返回放回的笔数
+func (u *UserRepo) UpdateWithdrawDoingTimeout(ctx context.Context, before time.Time) (int64, error) {
+	res := u.data.DB(ctx).Table("withdraw").Where("status=?", biz.WithdrawDoing).Where("tx_hash=?", "").
+		Where("updated_at<?", before.Format("2006-01-02 15:04:05")).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawApproved,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
 	}
-	return res, nil
+
+	return res.RowsAffected, nil
 }
 
-// GetUsersOpenCardStatusDoing .
-func (u *UserRepo) GetUsersOpenCardStatusDoing() ([]*biz.User, error) {
-	var users []*User
+// UpdateWithdrawReplaced 替换交易后记下最新一笔
+func (u *UserRepo) UpdateWithdrawReplaced(ctx context.Context, id uint64, txHash string, rawTx string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"tx_hash":    txHash,
+			"raw_tx":     rawTx,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
 
-	res := make([]*biz.User, 0)
-	if err := u.data.db.Table("user").Where("card!=?", "no").Where("card_number=?", "no").Order("id asc").Find(&users).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return res, nil
-		}
+	return nil
+}
 
-		return nil, errors.New(500, "USER ERROR", err.Error())
+// UpdateWithdrawConfirmed 已广播的提现写入回执结果，txHash 为实际上链的交易
+func (u *UserRepo) UpdateWithdrawConfirmed(ctx context.Context, id uint64, status string, txHash string, gasUsed uint64, blockNumber uint64) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"tx_hash":      txHash,
+			"gas_used":     gasUsed,
+			"block_number": blockNumber,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
 	}
 
-	for _, user := range users {
-		res = append(res, &biz.User{
-			CardAmount:    user.CardAmount,
-			MyTotalAmount: user.MyTotalAmount,
-			AmountTwo:     user.AmountTwo,
-			IsDelete:      user.IsDelete,
-			Vip:           user.Vip,
-			ID:            user.ID,
-			Address:       user.Address,
-			Card:          user.Card,
-			Amount:        user.Amount,
-			CreatedAt:     user.CreatedAt,
-			UpdatedAt:     user.UpdatedAt,
-			CardNumber:    user.CardNumber,
-			CardOrderId:   user.CardOrderId,
-			FirstName:     user.FirstName,
-			LastName:      user.LastName,
-			BirthDate:     user.BirthDate,
-			Email:         user.Email,
-			CountryCode:   user.CountryCode,
-			Phone:         user.Phone,
-			City:          user.City,
-			Country:       user.Country,
-			Street:        user.Street,
-			PostalCode:    user.PostalCode,
-			CardUserId:    user.CardUserId,
-			MaxCardQuota:  user.MaxCardQuota,
-			ProductId:     user.ProductId,
-			VipTwo:        user.VipTwo,
+	return nil
+}
+
+// UpdateWithdrawRequeue 放回待打款，清掉交易信息，已审核过的不再重新审核
+func (u *UserRepo) UpdateWithdrawRequeue(ctx context.Context, id uint64, from string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", from).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawApproved,
+			"tx_hash":    "",
+			"nonce":      0,
+			"raw_tx":     "",
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawsByStatus .
+func (u *UserRepo) GetWithdrawsByStatus(status string, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.db.Table("withdraw").Where("status=?", status).Order("id asc").Limit(limit).Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
 	}
+
 	return res, nil
 }
 
-// GetWithdrawPassOrRewardedFirst .
-func (u *UserRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Withdraw, error) {
+// GetWithdrawById .
+func (u *UserRepo) GetWithdrawById(id uint64) (*biz.Withdraw, error) {
 	var withdraw *Withdraw
-	if err := u.data.db.Table("withdraw").Where("status=?", "rewarded").First(&withdraw).Error; err != nil {
+	if err := u.data.db.Table("withdraw").Where("id=?", id).First(&withdraw).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+			return nil, nil
 		}
 
 		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
-	return &biz.Withdraw{
-		ID:        withdraw.ID,
-		UserId:    withdraw.UserId,
-		Amount:    withdraw.Amount,
-		RelAmount: withdraw.RelAmount,
-		Status:    withdraw.Status,
-		Address:   withdraw.Address,
-		CreatedAt: withdraw.CreatedAt,
-		UpdatedAt: withdraw.UpdatedAt,
-	}, nil
+	return toBizWithdraw(withdraw), nil
 }
 
-// CreateCardRecommend .
-func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("vip=?", vip).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
+// GetWithdraws .
+func (u *UserRepo) GetWithdraws(b *biz.Pagination, userId uint64, status string) ([]*biz.Withdraw, error, int64) {
 	var (
-		reward Reward
+		count     int64
+		withdraws []*Withdraw
 	)
 
-	reward.UserId = userId
-	reward.Amount = amount
-	reward.One = vip
-	reward.Reason = 6 // 给我分红的理由
-	reward.Address = address
-	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
-	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
-		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
-	}
+	res := make([]*biz.Withdraw, 0)
+
+	instance := u.data.db.Table("withdraw").Order("id desc")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), 0
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
+	}
+
+	return res, nil, count
+}
+
+// GetWithdrawAmountSum 用户某时间之后的提现合计，驳回的不算
+func (u *UserRepo) GetWithdrawAmountSum(userId uint64, startTime time.Time, exceptId uint64) (float64, error) {
+	var sum float64
+	if err := u.data.db.Table("withdraw").Select("IFNULL(SUM(amount), 0)").
+		Where("user_id=?", userId).
+		Where("created_at>=?", startTime).
+		Where("status<>?", biz.WithdrawRejected).
+		Where("id<>?", exceptId).
+		Scan(&sum).Error; err != nil {
+		return 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return sum, nil
+}
+
+// UpdateWithdrawReview 审核结果，通过时清掉失败交易的信息
+func (u *UserRepo) UpdateWithdrawReview(ctx context.Context, id uint64, from []string, status string, adminId uint64, remark string) error {
+	values := map[string]interface{}{
+		"status":     status,
+		"admin_id":   adminId,
+		"remark":     remark,
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if biz.WithdrawApproved == status {
+		values["tx_hash"] = ""
+		values["nonce"] = 0
+		values["raw_tx"] = ""
+		values["batch_id"] = 0
+	}
+
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status in (?)", from).Updates(values)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// RejectWithdraw 驳回提现，退回余额并记流水
+func (u *UserRepo) RejectWithdraw(ctx context.Context, w *biz.Withdraw, from []string, adminId uint64, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", w.ID).Where("status in (?)", from).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawRejected,
+			"admin_id":   adminId,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("user").Where("id=?", w.UserId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", w.Amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = w.UserId
+	reward.Amount = w.Amount
+	reward.Reason = 14 // 提现驳回退款
+	reward.Address = w.Address
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+// CreateWithdrawTx .
+func (u *UserRepo) CreateWithdrawTx(ctx context.Context, wt *biz.WithdrawTx) error {
+	var withdrawTx WithdrawTx
+	withdrawTx.WithdrawId = wt.WithdrawId
+	withdrawTx.BatchId = wt.BatchId
+	withdrawTx.TxHash = wt.TxHash
+	withdrawTx.Nonce = wt.Nonce
+	withdrawTx.GasPrice = wt.GasPrice
+	withdrawTx.Kind = wt.Kind
+	withdrawTx.RawTx = wt.RawTx
+	res := u.data.DB(ctx).Table("withdraw_tx").Create(&withdrawTx)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_WITHDRAW_TX_ERROR", "提现交易记录创建失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawTxs 新的在前，batchId 大于 0 时取批次的交易
+func (u *UserRepo) GetWithdrawTxs(withdrawId uint64, batchId uint64) ([]*biz.WithdrawTx, error) {
+	var withdrawTxs []*WithdrawTx
+	res := make([]*biz.WithdrawTx, 0)
+
+	instance := u.data.db.Table("withdraw_tx").Order("id desc")
+	if 0 < batchId {
+		instance = instance.Where("batch_id=?", batchId)
+	} else {
+		instance = instance.Where("withdraw_id=?", withdrawId)
+	}
+
+	if err := instance.Find(&withdrawTxs).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW TX ERROR", err.Error())
+	}
+
+	for _, v := range withdrawTxs {
+		res = append(res, &biz.WithdrawTx{
+			ID:         v.ID,
+			WithdrawId: v.WithdrawId,
+			BatchId:    v.BatchId,
+			TxHash:     v.TxHash,
+			Nonce:      v.Nonce,
+			GasPrice:   v.GasPrice,
+			Kind:       v.Kind,
+			RawTx:      v.RawTx,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// CreateWithdrawBatch .
+func (u *UserRepo) CreateWithdrawBatch(ctx context.Context, b *biz.WithdrawBatch) (uint64, error) {
+	var batch WithdrawBatch
+	batch.TxHash = b.TxHash
+	batch.Nonce = b.Nonce
+	batch.Count = b.Count
+	batch.Amount = b.Amount
+	batch.Status = b.Status
+	res := u.data.DB(ctx).Table("withdraw_batch").Create(&batch)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return 0, errors.New(500, "CREATE_WITHDRAW_BATCH_ERROR", "打款批次创建失败")
+	}
+
+	return batch.ID, nil
+}
+
+// UpdateWithdrawBatchSent 一批待打款的提现记到同一笔交易，任何一笔状态不对都返回错误
+func (u *UserRepo) UpdateWithdrawBatchSent(ctx context.Context, ids []uint64, batchId uint64, txHash string, nonce uint64, rawTx string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id in (?)", ids).Where("status=?", biz.WithdrawApproved).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawSent,
+			"batch_id":   batchId,
+			"tx_hash":    txHash,
+			"nonce":      nonce,
+			"raw_tx":     rawTx,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || int64(len(ids)) != res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawBatchReplaced 批次替换交易后记下最新一笔
+func (u *UserRepo) UpdateWithdrawBatchReplaced(ctx context.Context, batchId uint64, txHash string, rawTx string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("batch_id=?", batchId).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"tx_hash":    txHash,
+			"raw_tx":     rawTx,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("withdraw_batch").Where("id=?", batchId).
+		Updates(map[string]interface{}{
+			"tx_hash":    txHash,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_BATCH_ERROR", "打款批次修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawBatchConfirmed 批次写入回执结果，整批提现一起更新
+func (u *UserRepo) UpdateWithdrawBatchConfirmed(ctx context.Context, batchId uint64, status string, txHash string, gasUsed uint64, blockNumber uint64) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("batch_id=?", batchId).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"tx_hash":      txHash,
+			"gas_used":     gasUsed,
+			"block_number": blockNumber,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("withdraw_batch").Where("id=?", batchId).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"tx_hash":      txHash,
+			"gas_used":     gasUsed,
+			"block_number": blockNumber,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_BATCH_ERROR", "打款批次修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawBatchRequeue 批次整批放回待打款
+func (u *UserRepo) UpdateWithdrawBatchRequeue(ctx context.Context, batchId uint64) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("batch_id=?", batchId).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawApproved,
+			"batch_id":   0,
+			"tx_hash":    "",
+			"nonce":      0,
+			"raw_tx":     "",
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("withdraw_batch").Where("id=?", batchId).Where("status=?", biz.WithdrawSent).
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawApproved,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_BATCH_ERROR", "打款批次修改失败")
+	}
+
+	return nil
+}
+
+// GetWalletNonce 热钱包下一个 nonce，加行锁，需在事务中调用，没有记录时先建一条
+func (u *UserRepo) GetWalletNonce(ctx context.Context, address string) (uint64, error) {
+	res := u.data.DB(ctx).Table("wallet_nonce").Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WalletNonce{Address: address})
+	if res.Error != nil {
+		return 0, errors.New(500, "WALLET_NONCE_ERROR", res.Error.Error())
+	}
+
+	var walletNonce WalletNonce
+	if err := u.data.DB(ctx).Table("wallet_nonce").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("address=?", address).First(&walletNonce).Error; err != nil {
+		return 0, errors.New(500, "WALLET_NONCE_ERROR", err.Error())
+	}
+
+	return walletNonce.Nonce, nil
+}
+
+// SaveWalletNonce .
+func (u *UserRepo) SaveWalletNonce(ctx context.Context, address string, nonce uint64) error {
+	res := u.data.DB(ctx).Table("wallet_nonce").Where("address=?", address).
+		Updates(map[string]interface{}{
+			"nonce":      nonce,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "WALLET_NONCE_ERROR", "热钱包nonce保存失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawPassOrRewardedFirst .
+func (u *UserRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Withdraw, error) {
+	var withdraw *Withdraw
+	if err := u.data.db.Table("withdraw").Where("status=?", biz.WithdrawApproved).First(&withdraw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return toBizWithdraw(withdraw), nil
+}
+
+// CreateCardRecommend .
+func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("vip=?", vip).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+	var (
+		reward Reward
+	)
+
+	reward.UserId = userId
+	reward.Amount = amount
+	reward.One = vip
+	reward.Reason = 6 // 给我分红的理由
+	reward.Address = address
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
 
 	return nil
 }
@@ -951,17 +1583,60 @@ func (u *UserRepo) GetUserRewardByUserIdPage(ctx context.Context, b *biz.Paginat
 	return res, nil, count
 }
 
-func (u *UserRepo) GetEthUserRecordLast() (int64, error) {
-	var ethUserRecord *EthUserRecord
-	if err := u.data.db.Table("eth_user_record").Order("last desc").First(&ethUserRecord).Error; err != nil {
+// GetChainCursor 未扫描过返回 0
+func (u *UserRepo) GetChainCursor(name string) (uint64, error) {
+	var cursor ChainCursor
+	if err := u.data.db.Table("chain_cursor").Where("name=?", name).First(&cursor).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return 0, nil
 		}
 
-		return -1, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+		return 0, errors.New(500, "CHAIN CURSOR ERROR", err.Error())
+	}
+
+	return cursor.Block, nil
+}
+
+// SaveChainCursor 游标只前进
+func (u *UserRepo) SaveChainCursor(ctx context.Context, name string, block uint64) error {
+	cursor := ChainCursor{
+		Name:  name,
+		Block: block,
 	}
 
-	return ethUserRecord.Last, nil
+	res := u.data.DB(ctx).Table("chain_cursor").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"block":      gorm.Expr("GREATEST(block, ?)", block),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		}),
+	}).Create(&cursor)
+	if res.Error != nil {
+		return errors.New(500, "SAVE_CHAIN_CURSOR_ERROR", "扫描游标保存失败")
+	}
+
+	return nil
+}
+
+// CreateEthDeposit 已存在时返回 false，保证每笔充值只处理一次
+func (u *UserRepo) CreateEthDeposit(ctx context.Context, d *biz.EthDeposit) (bool, error) {
+	var deposit EthDeposit
+	deposit.TxHash = d.TxHash
+	deposit.LogIndex = d.LogIndex
+	deposit.BlockNumber = d.BlockNumber
+	deposit.UserId = d.UserId
+	deposit.Address = d.Address
+	deposit.Amount = d.Amount
+	deposit.AmountTwo = d.AmountTwo
+	deposit.Status = d.Status
+
+	res := u.data.DB(ctx).Table("eth_deposit").Clauses(clause.OnConflict{DoNothing: true}).Create(&deposit)
+	if res.Error != nil {
+		return false, errors.New(500, "CREATE_ETH_DEPOSIT_ERROR", "充值记录创建失败")
+	}
+
+	d.ID = deposit.ID
+	return 0 < res.RowsAffected, nil
 }
 
 // GetUserByAddresses .
@@ -1043,14 +1718,14 @@ func (u *UserRepo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64
 }
 
 // InsertCardRecord .
-func (u *UserRepo) InsertCardRecord(ctx context.Context, userId, recordType uint64, remark string, code string, opt string) error {
+func (u *UserRepo) InsertCardRecord(ctx context.Context, userId uint64, recordType biz.CardRecordType, remark string, code string, opt string) error {
 	var (
 		record CardRecord
 	)
 
 	record.UserId = userId
 	record.Remark = remark
-	record.RecordType = recordType
+	record.RecordType = uint64(recordType)
 	record.Code = code
 	record.Opt = opt
 	resInsert := u.data.DB(ctx).Table("card_record").Create(&record)
@@ -1118,37 +1793,38 @@ func (u *UserRepo) GetUsers(b *biz.Pagination, address string) ([]*biz.User, err
 	res := make([]*biz.User, 0)
 	for _, user := range users {
 		res = append(res, &biz.User{
-			ID:            user.ID,
-			Address:       user.Address,
-			Card:          user.Card,
-			CardNumber:    user.CardNumber,
-			CardOrderId:   user.CardOrderId,
-			CardAmount:    user.CardAmount,
-			Amount:        user.Amount,
-			AmountTwo:     user.AmountTwo,
-			MyTotalAmount: user.MyTotalAmount,
-			IsDelete:      user.IsDelete,
-			Vip:           user.Vip,
-			FirstName:     user.FirstName,
-			LastName:      user.LastName,
-			BirthDate:     user.BirthDate,
-			Email:         user.Email,
-			CountryCode:   user.CountryCode,
-			Phone:         user.Phone,
-			City:          user.City,
-			Country:       user.Country,
-			Street:        user.Street,
-			PostalCode:    user.PostalCode,
-			CardUserId:    user.CardUserId,
-			ProductId:     user.ProductId,
-			MaxCardQuota:  user.MaxCardQuota,
-			CreatedAt:     user.CreatedAt,
-			UpdatedAt:     user.UpdatedAt,
-			VipTwo:        user.VipTwo,
-			VipThree:      user.VipThree,
-			CanVip:        user.CanVip,
-			CardTwo:       user.CardTwo,
-			UserCount:     user.UserCount,
+			ID:             user.ID,
+			Address:        user.Address,
+			Card:           user.Card,
+			CardNumber:     u.data.decrypt(user.CardNumber),
+			CardOrderId:    user.CardOrderId,
+			CardAmount:     user.CardAmount,
+			Amount:         user.Amount,
+			AmountTwo:      user.AmountTwo,
+			MyTotalAmount:  user.MyTotalAmount,
+			IsDelete:       user.IsDelete,
+			Vip:            user.Vip,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			BirthDate:      u.data.decrypt(user.BirthDate),
+			Email:          u.data.decrypt(user.Email),
+			CountryCode:    user.CountryCode,
+			Phone:          u.data.decrypt(user.Phone),
+			City:           user.City,
+			Country:        user.Country,
+			Street:         u.data.decrypt(user.Street),
+			PostalCode:     user.PostalCode,
+			CardUserId:     user.CardUserId,
+			CardUserStatus: user.CardUserStatus,
+			ProductId:      user.ProductId,
+			MaxCardQuota:   user.MaxCardQuota,
+			CreatedAt:      user.CreatedAt,
+			UpdatedAt:      user.UpdatedAt,
+			VipTwo:         user.VipTwo,
+			VipThree:       user.VipThree,
+			CanVip:         user.CanVip,
+			CardTwo:        user.CardTwo,
+			UserCount:      user.UserCount,
 		})
 	}
 	return res, nil, count
@@ -1173,6 +1849,25 @@ func (u *UserRepo) GetAdminByAccount(ctx context.Context, account string, passwo
 	}, nil
 }
 
+// GetAdminById .
+func (u *UserRepo) GetAdminById(ctx context.Context, id uint64) (*biz.Admin, error) {
+	var admin Admin
+	if err := u.data.db.Where("id=?", id).Table("admin").First(&admin).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("ADMIN_NOT_FOUND", "admin not found")
+		}
+
+		return nil, errors.New(500, "ADMIN ERROR", err.Error())
+	}
+
+	return &biz.Admin{
+		ID:       admin.ID,
+		Password: admin.Password,
+		Account:  admin.Account,
+		Type:     admin.Type,
+	}, nil
+}
+
 func (u *UserRepo) SetCanVip(ctx context.Context, userId uint64, lock uint64) (bool, error) {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Updates(map[string]interface{}{"can_vip": lock})
 	if res.Error != nil {
@@ -1236,3 +1931,1417 @@ func (u *UserRepo) UpdateConfig(ctx context.Context, id int64, value string) (bo
 
 	return true, nil
 }
+
+// CreateCardCallbackEvent 回调落库，eventId 重复时不插入并返回 false
+func (u *UserRepo) CreateCardCallbackEvent(ctx context.Context, e *biz.CardCallbackEvent) (bool, error) {
+	var event CardCallbackEvent
+	event.EventId = e.EventId
+	event.EventType = e.EventType
+	event.EventName = e.EventName
+	event.SourceId = e.SourceId
+	event.Payload = e.Payload
+	event.Status = "pending"
+
+	// 报文里有卡号和持卡人信息，加密落库
+	if err := u.data.encrypt(&event.Payload); nil != err {
+		return false, errors.New(500, "ENCRYPT_ERROR", "回调信息加密失败")
+	}
+
+	res := u.data.DB(ctx).Table("card_callback_event").Clauses(clause.OnConflict{DoNothing: true}).Create(&event)
+	if res.Error != nil {
+		return false, errors.New(500, "CREATE_CARD_CALLBACK_EVENT_ERROR", "回调信息创建失败")
+	}
+
+	return 0 < res.RowsAffected, nil
+}
+
+// GetCardCallbackEventsPending 待处理和处理失败可重试的回调
+func (u *UserRepo) GetCardCallbackEventsPending(maxTimes uint64, limit int) ([]*biz.CardCallbackEvent, error) {
+	var events []*CardCallbackEvent
+	res := make([]*biz.CardCallbackEvent, 0)
+	if err := u.data.db.Table("card_callback_event").Where("status IN (?)", []string{"pending", "fail"}).Where("times<?", maxTimes).
+		Order("id asc").Limit(limit).Find(&events).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD CALLBACK EVENT ERROR", err.Error())
+	}
+
+	for _, event := range events {
+		res = append(res, toBizCardCallbackEvent(event, u.data.decrypt(event.Payload)))
+	}
+
+	return res, nil
+}
+
+// UpdateCardCallbackEvent .
+func (u *UserRepo) UpdateCardCallbackEvent(ctx context.Context, id uint64, status string, remark string) error {
+	if 500 < len(remark) {
+		remark = remark[:500]
+	}
+
+	res := u.data.DB(ctx).Table("card_callback_event").Where("id=?", id).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"remark":     remark,
+			"times":      gorm.Expr("times + ?", 1),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_CALLBACK_EVENT_ERROR", "回调信息修改失败")
+	}
+
+	return nil
+}
+
+// GetCardCallbackEvents .
+func (u *UserRepo) GetCardCallbackEvents(b *biz.Pagination, eventId string, status string) ([]*biz.CardCallbackEvent, error, int64) {
+	var (
+		events []*CardCallbackEvent
+		count  int64
+	)
+	res := make([]*biz.CardCallbackEvent, 0)
+
+	instance := u.data.db.Table("card_callback_event")
+	if "" != eventId {
+		instance = instance.Where("event_id=?", eventId)
+	}
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&events).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD CALLBACK EVENT ERROR", err.Error()), 0
+	}
+
+	for _, event := range events {
+		res = append(res, toBizCardCallbackEvent(event, u.data.decrypt(event.Payload)))
+	}
+
+	return res, nil, count
+}
+
+// toBizCardCallbackEvent payload 为解密后的报文
+func toBizCardCallbackEvent(event *CardCallbackEvent, payload string) *biz.CardCallbackEvent {
+	return &biz.CardCallbackEvent{
+		ID:        event.ID,
+		EventId:   event.EventId,
+		EventType: event.EventType,
+		EventName: event.EventName,
+		SourceId:  event.SourceId,
+		Payload:   payload,
+		Status:    event.Status,
+		Times:     event.Times,
+		Remark:    event.Remark,
+		CreatedAt: event.CreatedAt,
+		UpdatedAt: event.UpdatedAt,
+	}
+}
+
+// SaveCardProduct 同步发卡方产品，已存在的只更新发卡方字段
+func (u *UserRepo) SaveCardProduct(ctx context.Context, p *biz.CardProductInfo) error {
+	var product CardProduct
+	product.ProductId = p.ProductId
+	product.ProductName = p.ProductName
+	product.ModeType = p.ModeType
+	product.CardBin = p.CardBin
+	product.CardScheme = p.CardScheme
+	product.CardForm = p.CardForm
+	product.CardCurrency = p.CardCurrency
+	product.MaxCardQuota = p.MaxCardQuota
+	product.ProductStatus = p.ProductStatus
+	product.Enable = 1
+
+	res := u.data.DB(ctx).Table("card_product").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "product_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"product_name", "mode_type", "card_bin", "card_scheme", "card_form", "card_currency", "max_card_quota", "product_status", "updated_at"}),
+	}).Create(&product)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_CARD_PRODUCT_ERROR", "产品信息保存失败")
+	}
+
+	return nil
+}
+
+// GetCardProducts .
+func (u *UserRepo) GetCardProducts() ([]*biz.CardProductInfo, error) {
+	var products []*CardProduct
+	res := make([]*biz.CardProductInfo, 0)
+	if err := u.data.db.Table("card_product").Order("id asc").Find(&products).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD PRODUCT ERROR", err.Error())
+	}
+
+	for _, product := range products {
+		res = append(res, toBizCardProduct(product))
+	}
+
+	return res, nil
+}
+
+// GetCardProductByProductId .
+func (u *UserRepo) GetCardProductByProductId(productId string) (*biz.CardProductInfo, error) {
+	var product CardProduct
+	if err := u.data.db.Table("card_product").Where("product_id=?", productId).First(&product).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD PRODUCT ERROR", err.Error())
+	}
+
+	return toBizCardProduct(&product), nil
+}
+
+// SetCardProductEnable .
+func (u *UserRepo) SetCardProductEnable(ctx context.Context, productId string, enable uint64) error {
+	res := u.data.DB(ctx).Table("card_product").Where("product_id=?", productId).
+		Updates(map[string]interface{}{
+			"enable":     enable,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_PRODUCT_ERROR", "产品信息修改失败")
+	}
+
+	return nil
+}
+
+// GetCardProductDefaults .
+func (u *UserRepo) GetCardProductDefaults() ([]*biz.CardProductDefault, error) {
+	var defaults []*CardProductDefault
+	res := make([]*biz.CardProductDefault, 0)
+	if err := u.data.db.Table("card_product_default").Order("vip_two asc").Find(&defaults).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD PRODUCT DEFAULT ERROR", err.Error())
+	}
+
+	for _, v := range defaults {
+		res = append(res, &biz.CardProductDefault{
+			ID:        v.ID,
+			VipTwo:    v.VipTwo,
+			ProductId: v.ProductId,
+		})
+	}
+
+	return res, nil
+}
+
+// GetCardProductDefault 区域默认产品
+func (u *UserRepo) GetCardProductDefault(vipTwo uint64) (*biz.CardProductInfo, error) {
+	var productDefault CardProductDefault
+	if err := u.data.db.Table("card_product_default").Where("vip_two=?", vipTwo).First(&productDefault).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD PRODUCT DEFAULT ERROR", err.Error())
+	}
+
+	return u.GetCardProductByProductId(productDefault.ProductId)
+}
+
+// SetCardProductDefault .
+func (u *UserRepo) SetCardProductDefault(ctx context.Context, vipTwo uint64, productId string) error {
+	var productDefault CardProductDefault
+	productDefault.VipTwo = vipTwo
+	productDefault.ProductId = productId
+
+	res := u.data.DB(ctx).Table("card_product_default").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "vip_two"}},
+		DoUpdates: clause.AssignmentColumns([]string{"product_id", "updated_at"}),
+	}).Create(&productDefault)
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_CARD_PRODUCT_DEFAULT_ERROR", "默认产品设置失败")
+	}
+
+	return nil
+}
+
+func toBizCardProduct(product *CardProduct) *biz.CardProductInfo {
+	return &biz.CardProductInfo{
+		ID:            product.ID,
+		ProductId:     product.ProductId,
+		ProductName:   product.ProductName,
+		ModeType:      product.ModeType,
+		CardBin:       product.CardBin,
+		CardScheme:    product.CardScheme,
+		CardForm:      product.CardForm,
+		CardCurrency:  product.CardCurrency,
+		MaxCardQuota:  product.MaxCardQuota,
+		ProductStatus: product.ProductStatus,
+		Enable:        product.Enable,
+		UserCardMax:   product.UserCardMax,
+		CreatedAt:     product.CreatedAt,
+		UpdatedAt:     product.UpdatedAt,
+	}
+}
+
+// CreateCardRecharge 扣余额并创建充值订单
+func (u *UserRepo) CreateCardRecharge(ctx context.Context, r *biz.CardRecharge) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", r.UserId).Where("amount>=?", r.Amount).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount - ?", r.Amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = r.UserId
+	reward.Amount = r.Amount
+	reward.Reason = 4 // 给我分红的理由
+	reward.Address = r.ReferenceCode
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	var recharge CardRecharge
+	recharge.UserId = r.UserId
+	recharge.CardId = r.CardId
+	recharge.Amount = r.Amount
+	recharge.ReferenceCode = r.ReferenceCode
+	recharge.Status = r.Status
+	resTwo := u.data.DB(ctx).Table("card_recharge").Create(&recharge)
+	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
+		return errors.New(500, "CREATE_CARD_RECHARGE_ERROR", "充值订单创建失败")
+	}
+	r.ID = recharge.ID
+
+	return nil
+}
+
+// GetCardRechargesPending .
+func (u *UserRepo) GetCardRechargesPending(limit int) ([]*biz.CardRecharge, error) {
+	var recharges []*CardRecharge
+	res := make([]*biz.CardRecharge, 0)
+	if err := u.data.db.Table("card_recharge").Where("status=?", "pending").Order("id asc").Limit(limit).Find(&recharges).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD RECHARGE ERROR", err.Error())
+	}
+
+	for _, recharge := range recharges {
+		res = append(res, toBizCardRecharge(recharge))
+	}
+
+	return res, nil
+}
+
+// GetCardRechargeByReferenceCode .
+func (u *UserRepo) GetCardRechargeByReferenceCode(referenceCode string) (*biz.CardRecharge, error) {
+	var recharge CardRecharge
+	if err := u.data.db.Table("card_recharge").Where("reference_code=?", referenceCode).First(&recharge).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD RECHARGE ERROR", err.Error())
+	}
+
+	return toBizCardRecharge(&recharge), nil
+}
+
+// UpdateCardRecharge 充值订单状态只能从 from 中的状态修改
+func (u *UserRepo) UpdateCardRecharge(ctx context.Context, id uint64, from []string, status string, orderId string, remark string) error {
+	if 500 < len(remark) {
+		remark = remark[:500]
+	}
+
+	updates := map[string]interface{}{
+		"status":     status,
+		"remark":     remark,
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if "" != orderId {
+		updates["order_id"] = orderId
+	}
+
+	res := u.data.DB(ctx).Table("card_recharge").Where("id=?", id).Where("status IN (?)", from).Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_RECHARGE_ERROR", "充值订单修改失败")
+	}
+
+	return nil
+}
+
+// CardRechargeBack 充值失败退回余额
+func (u *UserRepo) CardRechargeBack(ctx context.Context, r *biz.CardRecharge) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", r.UserId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", r.Amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = r.UserId
+	reward.Amount = r.Amount
+	reward.Reason = 12 // 给我分红的理由
+	reward.Address = r.ReferenceCode
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+// GetCardRecharges .
+func (u *UserRepo) GetCardRecharges(b *biz.Pagination, userId uint64, status string) ([]*biz.CardRecharge, error, int64) {
+	var (
+		recharges []*CardRecharge
+		count     int64
+	)
+	res := make([]*biz.CardRecharge, 0)
+
+	instance := u.data.db.Table("card_recharge")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&recharges).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD RECHARGE ERROR", err.Error()), 0
+	}
+
+	for _, recharge := range recharges {
+		res = append(res, toBizCardRecharge(recharge))
+	}
+
+	return res, nil, count
+}
+
+func toBizCardRecharge(recharge *CardRecharge) *biz.CardRecharge {
+	return &biz.CardRecharge{
+		ID:            recharge.ID,
+		UserId:        recharge.UserId,
+		CardId:        recharge.CardId,
+		Amount:        recharge.Amount,
+		ReferenceCode: recharge.ReferenceCode,
+		OrderId:       recharge.OrderId,
+		Status:        recharge.Status,
+		Remark:        recharge.Remark,
+		CreatedAt:     recharge.CreatedAt,
+		UpdatedAt:     recharge.UpdatedAt,
+	}
+}
+
+// CancelCard 销卡，余额退回，销的是主卡时 user 表卡片信息重置为可重新开卡
+func (u *UserRepo) CancelCard(ctx context.Context, userId uint64, cardId string, balance float64) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", balance),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("user").Where("id=?", userId).Where("card=?", cardId).
+		Updates(map[string]interface{}{
+			"card_order_id": "no",
+			"card":          "no",
+			"card_number":   "no",
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	if 0 >= balance {
+		return nil
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = userId
+	reward.Amount = balance
+	reward.Reason = 13 // 给我分红的理由
+	reward.Address = cardId
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+// SaveCardTransaction 按 transaction_id 写入，重复同步时更新状态和金额
+func (u *UserRepo) SaveCardTransaction(ctx context.Context, t *biz.CardTransaction) error {
+	var transaction CardTransaction
+	transaction.UserId = t.UserId
+	transaction.CardId = t.CardId
+	transaction.TransactionId = t.TransactionId
+	transaction.TransactionType = t.TransactionType
+	transaction.Status = t.Status
+	transaction.Amount = t.Amount
+	transaction.Currency = t.Currency
+	transaction.MerchantName = t.MerchantName
+	transaction.Mcc = t.Mcc
+	transaction.TransactionTime = t.TransactionTime
+
+	columns := []string{"transaction_type", "status", "amount", "currency", "merchant_name", "mcc", "transaction_time", "updated_at"}
+	if 0 < t.UserId {
+		columns = append(columns, "user_id")
+	}
+
+	res := u.data.DB(ctx).Table("card_transaction").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "transaction_id"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).Create(&transaction)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_CARD_TRANSACTION_ERROR", "交易流水保存失败")
+	}
+
+	return nil
+}
+
+// GetCardTransactionLastTime 已同步的最后交易时间，没有记录返回零值
+func (u *UserRepo) GetCardTransactionLastTime() (time.Time, error) {
+	var transaction CardTransaction
+	if err := u.data.db.Table("card_transaction").Order("transaction_time desc").First(&transaction).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, errors.New(500, "CARD TRANSACTION ERROR", err.Error())
+	}
+
+	return transaction.TransactionTime, nil
+}
+
+// GetCardTransactions 时间为零值时不过滤
+func (u *UserRepo) GetCardTransactions(b *biz.Pagination, userId uint64, cardId string, status string, startTime time.Time, endTime time.Time) ([]*biz.CardTransaction, error, int64) {
+	var (
+		transactions []*CardTransaction
+		count        int64
+	)
+	res := make([]*biz.CardTransaction, 0)
+
+	instance := u.data.db.Table("card_transaction")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != cardId {
+		instance = instance.Where("card_id=?", cardId)
+	}
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+	if !startTime.IsZero() {
+		instance = instance.Where("transaction_time>=?", startTime.UTC())
+	}
+	if !endTime.IsZero() {
+		instance = instance.Where("transaction_time<?", endTime.UTC())
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("transaction_time desc").Find(&transactions).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD TRANSACTION ERROR", err.Error()), 0
+	}
+
+	for _, transaction := range transactions {
+		res = append(res, &biz.CardTransaction{
+			ID:              transaction.ID,
+			UserId:          transaction.UserId,
+			CardId:          transaction.CardId,
+			TransactionId:   transaction.TransactionId,
+			TransactionType: transaction.TransactionType,
+			Status:          transaction.Status,
+			Amount:          transaction.Amount,
+			Currency:        transaction.Currency,
+			MerchantName:    transaction.MerchantName,
+			Mcc:             transaction.Mcc,
+			TransactionTime: transaction.TransactionTime,
+			CreatedAt:       transaction.CreatedAt,
+			UpdatedAt:       transaction.UpdatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetCardRechargeSum 卡片充值成功的总额
+func (u *UserRepo) GetCardRechargeSum(cardId string) (float64, error) {
+	var total struct {
+		Total float64
+	}
+	if err := u.data.db.Table("card_recharge").Where("card_id=?", cardId).Where("status=?", "success").
+		Select("COALESCE(SUM(amount), 0) as total").Take(&total).Error; err != nil {
+		return 0, errors.New(500, "CARD RECHARGE ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// GetCardTransactionSum 卡片某类交易的总额
+func (u *UserRepo) GetCardTransactionSum(cardId string, transactionType string, status string) (float64, error) {
+	var total struct {
+		Total float64
+	}
+	if err := u.data.db.Table("card_transaction").Where("card_id=?", cardId).Where("transaction_type=?", transactionType).Where("status=?", status).
+		Select("COALESCE(SUM(amount), 0) as total").Take(&total).Error; err != nil {
+		return 0, errors.New(500, "CARD TRANSACTION ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
+// SaveCardBalance 每张卡保留最新一次对账结果，同时更新用户的卡内余额
+func (u *UserRepo) SaveCardBalance(ctx context.Context, b *biz.CardBalance) error {
+	var balance CardBalance
+	balance.UserId = b.UserId
+	balance.CardId = b.CardId
+	balance.Balance = b.Balance
+	balance.Expected = b.Expected
+	balance.Drift = b.Drift
+	balance.CheckedAt = b.CheckedAt
+
+	res := u.data.DB(ctx).Table("card_balance").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "card_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "balance", "expected", "drift", "checked_at", "updated_at"}),
+	}).Create(&balance)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_CARD_BALANCE_ERROR", "卡片余额保存失败")
+	}
+
+	res = u.data.DB(ctx).Table("user").Where("id=?", b.UserId).Where("card=?", b.CardId).
+		Updates(map[string]interface{}{"card_amount": b.Balance, "updated_at": time.Now().Format("2006-01-02 15:04:05")})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	return nil
+}
+
+// GetCardBalancesDrift 对账不一致的卡片
+func (u *UserRepo) GetCardBalancesDrift(b *biz.Pagination, userId uint64) ([]*biz.CardBalance, error, int64) {
+	var (
+		balances []*CardBalance
+		count    int64
+	)
+	res := make([]*biz.CardBalance, 0)
+
+	instance := u.data.db.Table("card_balance").Where("drift=?", 1)
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&balances).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD BALANCE ERROR", err.Error()), 0
+	}
+
+	for _, balance := range balances {
+		res = append(res, &biz.CardBalance{
+			ID:        balance.ID,
+			UserId:    balance.UserId,
+			CardId:    balance.CardId,
+			Balance:   balance.Balance,
+			Expected:  balance.Expected,
+			Drift:     balance.Drift,
+			CheckedAt: balance.CheckedAt,
+			CreatedAt: balance.CreatedAt,
+			UpdatedAt: balance.UpdatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// CreateCardTwoOrder 一条开实体卡记录只生成一个订单
+func (u *UserRepo) CreateCardTwoOrder(ctx context.Context, o *biz.CardTwoOrder) error {
+	var order CardTwoOrder
+	order.UserId = o.UserId
+	order.RewardId = o.RewardId
+	order.Status = o.Status
+	order.Receiver = o.Receiver
+	order.Phone = o.Phone
+	order.Country = o.Country
+	order.City = o.City
+	order.Street = o.Street
+	order.PostalCode = o.PostalCode
+	if err := u.data.encrypt(&order.Phone, &order.Street); nil != err {
+		return errors.New(500, "ENCRYPT_ERROR", "收货信息加密失败")
+	}
+
+	res := u.data.DB(ctx).Table("card_two_order").Clauses(clause.OnConflict{DoNothing: true}).Create(&order)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_CARD_TWO_ORDER_ERROR", "实体卡订单创建失败")
+	}
+
+	return nil
+}
+
+// GetCardTwoOrderById .
+func (u *UserRepo) GetCardTwoOrderById(id uint64) (*biz.CardTwoOrder, error) {
+	var order CardTwoOrder
+	if err := u.data.db.Table("card_two_order").Where("id=?", id).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD TWO ORDER ERROR", err.Error())
+	}
+
+	return u.toBizCardTwoOrder(&order), nil
+}
+
+// GetCardTwoOrdersUnRewarded 状态在 status 中且未分红的订单
+func (u *UserRepo) GetCardTwoOrdersUnRewarded(status []string) ([]*biz.CardTwoOrder, error) {
+	var orders []*CardTwoOrder
+	res := make([]*biz.CardTwoOrder, 0)
+	if err := u.data.db.Table("card_two_order").Where("rewarded=?", 0).Where("status in (?)", status).Order("id asc").Find(&orders).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD TWO ORDER ERROR", err.Error())
+	}
+
+	for _, order := range orders {
+		res = append(res, u.toBizCardTwoOrder(order))
+	}
+
+	return res, nil
+}
+
+// UpdateCardTwoOrderStatus 订单状态只能从 from 修改，trackingNo 为空不修改
+func (u *UserRepo) UpdateCardTwoOrderStatus(ctx context.Context, id uint64, from string, status string, trackingNo string) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if "" != trackingNo {
+		updates["tracking_no"] = trackingNo
+	}
+
+	res := u.data.DB(ctx).Table("card_two_order").Where("id=?", id).Where("status=?", from).Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_TWO_ORDER_ERROR", "实体卡订单修改失败")
+	}
+
+	return nil
+}
+
+// UpdateCardTwoOrderAddress 发货前可以修改收货地址
+func (u *UserRepo) UpdateCardTwoOrderAddress(ctx context.Context, o *biz.CardTwoOrder, status []string) error {
+	phone, street := o.Phone, o.Street
+	if err := u.data.encrypt(&phone, &street); nil != err {
+		return errors.New(500, "ENCRYPT_ERROR", "收货信息加密失败")
+	}
+
+	res := u.data.DB(ctx).Table("card_two_order").Where("id=?", o.ID).Where("status in (?)", status).
+		Updates(map[string]interface{}{
+			"receiver":    o.Receiver,
+			"phone":       phone,
+			"country":     o.Country,
+			"city":        o.City,
+			"street":      street,
+			"postal_code": o.PostalCode,
+			"updated_at":  time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_TWO_ORDER_ERROR", "实体卡订单修改失败")
+	}
+
+	return nil
+}
+
+// UpdateCardTwoOrderRewarded 分红前先标记，避免重复分红
+func (u *UserRepo) UpdateCardTwoOrderRewarded(ctx context.Context, id uint64) error {
+	res := u.data.DB(ctx).Table("card_two_order").Where("id=?", id).Where("rewarded=?", 0).
+		Updates(map[string]interface{}{
+			"rewarded":   1,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_TWO_ORDER_ERROR", "实体卡订单修改失败")
+	}
+
+	return nil
+}
+
+// GetCardTwoOrders .
+func (u *UserRepo) GetCardTwoOrders(b *biz.Pagination, userId uint64, status string) ([]*biz.CardTwoOrder, error, int64) {
+	var (
+		orders []*CardTwoOrder
+		count  int64
+	)
+	res := make([]*biz.CardTwoOrder, 0)
+
+	instance := u.data.db.Table("card_two_order")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&orders).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD TWO ORDER ERROR", err.Error()), 0
+	}
+
+	for _, order := range orders {
+		res = append(res, u.toBizCardTwoOrder(order))
+	}
+
+	return res, nil, count
+}
+
+func (u *UserRepo) toBizCardTwoOrder(order *CardTwoOrder) *biz.CardTwoOrder {
+	return &biz.CardTwoOrder{
+		ID:         order.ID,
+		UserId:     order.UserId,
+		RewardId:   order.RewardId,
+		Status:     order.Status,
+		TrackingNo: order.TrackingNo,
+		Receiver:   order.Receiver,
+		Phone:      u.data.decrypt(order.Phone),
+		Country:    order.Country,
+		City:       order.City,
+		Street:     u.data.decrypt(order.Street),
+		PostalCode: order.PostalCode,
+		Rewarded:   order.Rewarded,
+		CreatedAt:  order.CreatedAt,
+		UpdatedAt:  order.UpdatedAt,
+	}
+}
+
+// createUserCardHistory .
+func (u *UserRepo) createUserCardHistory(ctx context.Context, userCardId, userId uint64, from, to, remark string) error {
+	if 500 < len(remark) {
+		remark = remark[:500]
+	}
+
+	var history UserCardHistory
+	history.UserCardId = userCardId
+	history.UserId = userId
+	history.FromStatus = from
+	history.ToStatus = to
+	history.Remark = remark
+
+	res := u.data.DB(ctx).Table("user_card_history").Create(&history)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_USER_CARD_HISTORY_ERROR", "卡片记录创建失败")
+	}
+
+	return nil
+}
+
+// CreateUserCard 新建卡片并记录历史
+func (u *UserRepo) CreateUserCard(ctx context.Context, c *biz.UserCard, remark string) error {
+	var card UserCard
+	card.UserId = c.UserId
+	card.CardId = c.CardId
+	card.CardOrderId = c.CardOrderId
+	card.CardNumber = c.CardNumber
+	card.ProductId = c.ProductId
+	card.Status = c.Status
+	card.Main = c.Main
+	card.Fee = c.Fee
+	card.FeeRewardId = c.FeeRewardId
+	if err := u.data.encrypt(&card.CardNumber); nil != err {
+		return errors.New(500, "ENCRYPT_ERROR", "卡号加密失败")
+	}
+
+	res := u.data.DB(ctx).Table("user_card").Create(&card)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_USER_CARD_ERROR", "卡片创建失败")
+	}
+	c.ID = card.ID
+
+	return u.createUserCardHistory(ctx, card.ID, card.UserId, "", card.Status, remark)
+}
+
+// UpdateUserCardStatus 卡片状态只能从 c.Status 修改，卡片字段不为空的一起修改
+func (u *UserRepo) UpdateUserCardStatus(ctx context.Context, c *biz.UserCard, status string, remark string) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if "" != c.CardId {
+		updates["card_id"] = c.CardId
+	}
+	if "" != c.CardOrderId {
+		updates["card_order_id"] = c.CardOrderId
+	}
+	if "" != c.CardNumber {
+		cardNumber := c.CardNumber
+		if err := u.data.encrypt(&cardNumber); nil != err {
+			return errors.New(500, "ENCRYPT_ERROR", "卡号加密失败")
+		}
+		updates["card_number"] = cardNumber
+	}
+	if "" != c.ProductId {
+		updates["product_id"] = c.ProductId
+	}
+
+	res := u.data.DB(ctx).Table("user_card").Where("id=?", c.ID).Where("status=?", c.Status).Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_CARD_ERROR", "卡片状态修改失败")
+	}
+
+	return u.createUserCardHistory(ctx, c.ID, c.UserId, c.Status, status, remark)
+}
+
+// GetUserCardsByStatus .
+func (u *UserRepo) GetUserCardsByStatus(status ...string) ([]*biz.UserCard, error) {
+	var cards []*UserCard
+	res := make([]*biz.UserCard, 0)
+	if err := u.data.db.Table("user_card").Where("status in (?)", status).Order("id asc").Find(&cards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER CARD ERROR", err.Error())
+	}
+
+	for _, card := range cards {
+		res = append(res, u.toBizUserCard(card))
+	}
+
+	return res, nil
+}
+
+// GetUserCardByCardId .
+func (u *UserRepo) GetUserCardByCardId(cardId string) (*biz.UserCard, error) {
+	var card UserCard
+	if err := u.data.db.Table("user_card").Where("card_id=?", cardId).Order("id desc").First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER CARD ERROR", err.Error())
+	}
+
+	return u.toBizUserCard(&card), nil
+}
+
+// GetUserCardCurrent 用户当前未结束(开卡失败、已销卡)的卡片
+func (u *UserRepo) GetUserCardCurrent(userId uint64) (*biz.UserCard, error) {
+	var card UserCard
+	if err := u.data.db.Table("user_card").Where("user_id=?", userId).
+		Where("status in (?)", biz.UserCardCurrentStatus).
+		Order("id desc").First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER CARD ERROR", err.Error())
+	}
+
+	return u.toBizUserCard(&card), nil
+}
+
+// GetUsersWithoutUserCard user 表显示在开卡或已开卡，但没有对应卡片记录的用户
+func (u *UserRepo) GetUsersWithoutUserCard() ([]*biz.User, error) {
+	var users []*User
+
+	res := make([]*biz.User, 0)
+	if err := u.data.db.Table("user").Where("card_order_id!=?", "no").
+		Where("NOT EXISTS (SELECT 1 FROM user_card WHERE user_card.user_id=user.id AND user_card.main=1 AND user_card.status IN (?))",
+			biz.UserCardCurrentStatus).
+		Order("id asc").Find(&users).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	for _, user := range users {
+		res = append(res, &biz.User{
+			ID:          user.ID,
+			Address:     user.Address,
+			Card:        user.Card,
+			CardNumber:  u.data.decrypt(user.CardNumber),
+			CardOrderId: user.CardOrderId,
+			ProductId:   user.ProductId,
+			CreatedAt:   user.CreatedAt,
+			UpdatedAt:   user.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetUserCardHistories .
+func (u *UserRepo) GetUserCardHistories(b *biz.Pagination, userId uint64, userCardId uint64) ([]*biz.UserCardHistory, error, int64) {
+	var (
+		histories []*UserCardHistory
+		count     int64
+	)
+	res := make([]*biz.UserCardHistory, 0)
+
+	instance := u.data.db.Table("user_card_history")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if 0 < userCardId {
+		instance = instance.Where("user_card_id=?", userCardId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&histories).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "USER CARD HISTORY ERROR", err.Error()), 0
+	}
+
+	for _, history := range histories {
+		res = append(res, &biz.UserCardHistory{
+			ID:         history.ID,
+			UserCardId: history.UserCardId,
+			UserId:     history.UserId,
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			Remark:     history.Remark,
+			CreatedAt:  history.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// CreateCardRevealLog .
+func (u *UserRepo) CreateCardRevealLog(ctx context.Context, l *biz.CardRevealLog) error {
+	var revealLog CardRevealLog
+	revealLog.AdminId = l.AdminId
+	revealLog.UserId = l.UserId
+	revealLog.CardId = l.CardId
+	revealLog.Reason = l.Reason
+	revealLog.Result = l.Result
+
+	res := u.data.db.WithContext(ctx).Table("card_reveal_log").Create(&revealLog)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_CARD_REVEAL_LOG_ERROR", res.Error.Error())
+	}
+
+	return nil
+}
+
+func (u *UserRepo) GetCardRevealLogs(b *biz.Pagination, userId uint64, cardId string) ([]*biz.CardRevealLog, error, int64) {
+	var (
+		logs  []*CardRevealLog
+		count int64
+	)
+	res := make([]*biz.CardRevealLog, 0)
+
+	instance := u.data.db.Table("card_reveal_log")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != cardId {
+		instance = instance.Where("card_id=?", cardId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&logs).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD REVEAL LOG ERROR", err.Error()), 0
+	}
+
+	for _, v := range logs {
+		res = append(res, &biz.CardRevealLog{
+			ID:        v.ID,
+			AdminId:   v.AdminId,
+			UserId:    v.UserId,
+			CardId:    v.CardId,
+			Reason:    v.Reason,
+			Result:    v.Result,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// EncryptSensitiveFields 分批加密明文的卡号和KYC字段，未配置密钥时不处理
+func (u *UserRepo) EncryptSensitiveFields(ctx context.Context) (int64, error) {
+	if !u.data.cipher.Enabled() {
+		return 0, nil
+	}
+
+	var (
+		count  int64
+		tables = []struct {
+			table   string
+			columns []string
+		}{
+			{"user", []string{"card_number", "birth_date", "email", "phone", "street"}},
+			{"user_card", []string{"card_number"}},
+			{"card_two_order", []string{"phone", "street"}},
+		}
+	)
+
+	for _, t := range tables {
+		for _, column := range t.columns {
+			tmpCount, err := u.encryptColumn(ctx, t.table, column)
+			count += tmpCount
+			if nil != err {
+				return count, err
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func (u *UserRepo) encryptColumn(ctx context.Context, table string, column string) (int64, error) {
+	type row struct {
+		ID    uint64
+		Value string
+	}
+
+	var (
+		lastId uint64
+		count  int64
+	)
+	for {
+		rows := make([]*row, 0)
+		if err := u.data.db.WithContext(ctx).Table(table).Select("id, "+column+" as value").
+			Where("id>?", lastId).Where(column+" not like ?", "enc:%").Where(column+" not in (?)", []string{"", "no"}).
+			Order("id asc").Limit(500).Scan(&rows).Error; nil != err {
+			return count, errors.New(500, "ENCRYPT_ERROR", err.Error())
+		}
+		if 0 >= len(rows) {
+			return count, nil
+		}
+
+		for _, v := range rows {
+			lastId = v.ID
+
+			value := v.Value
+			if err := u.data.encrypt(&value); nil != err {
+				return count, errors.New(500, "ENCRYPT_ERROR", err.Error())
+			}
+
+			// 只在值没有被改过时更新
+			res := u.data.db.WithContext(ctx).Table(table).Where("id=?", v.ID).Where(column+"=?", v.Value).UpdateColumn(column, value)
+			if nil != res.Error {
+				return count, errors.New(500, "ENCRYPT_ERROR", res.Error.Error())
+			}
+			count += res.RowsAffected
+		}
+	}
+}
+
+// CreateIssuerJournal 不跟随业务事务，业务回滚时请求日志仍然保留
+func (u *UserRepo) CreateIssuerJournal(ctx context.Context, j *biz.IssuerJournal) error {
+	var journal IssuerJournal
+	journal.Method = j.Method
+	journal.Path = j.Path
+	journal.CardId = j.CardId
+	journal.Reference = j.Reference
+	journal.Attempt = j.Attempt
+	journal.StatusCode = j.StatusCode
+	journal.Duration = j.Duration
+	journal.Request = j.Request
+	journal.Response = j.Response
+	journal.Error = j.Error
+
+	res := u.data.db.WithContext(ctx).Table("issuer_journal").Create(&journal)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_ISSUER_JOURNAL_ERROR", res.Error.Error())
+	}
+
+	return nil
+}
+
+func (u *UserRepo) GetIssuerJournals(b *biz.Pagination, path string, cardId string, reference string, failed bool, startTime time.Time, endTime time.Time) ([]*biz.IssuerJournal, error, int64) {
+	var (
+		journals []*IssuerJournal
+		count    int64
+	)
+	res := make([]*biz.IssuerJournal, 0)
+
+	instance := u.data.db.Table("issuer_journal")
+	if "" != path {
+		instance = instance.Where("path=?", path)
+	}
+	if "" != cardId {
+		instance = instance.Where("card_id=?", cardId)
+	}
+	if "" != reference {
+		instance = instance.Where("reference=?", reference)
+	}
+	if failed {
+		instance = instance.Where("error<>?", "")
+	}
+	if !startTime.IsZero() {
+		instance = instance.Where("created_at>=?", startTime.UTC())
+	}
+	if !endTime.IsZero() {
+		instance = instance.Where("created_at<?", endTime.UTC())
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&journals).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "ISSUER JOURNAL ERROR", err.Error()), 0
+	}
+
+	for _, journal := range journals {
+		res = append(res, &biz.IssuerJournal{
+			ID:         journal.ID,
+			Method:     journal.Method,
+			Path:       journal.Path,
+			CardId:     journal.CardId,
+			Reference:  journal.Reference,
+			Attempt:    journal.Attempt,
+			StatusCode: journal.StatusCode,
+			Duration:   journal.Duration,
+			Request:    journal.Request,
+			Response:   journal.Response,
+			Error:      journal.Error,
+			CreatedAt:  journal.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+func (u *UserRepo) toBizUserCard(card *UserCard) *biz.UserCard {
+	return &biz.UserCard{
+		ID:          card.ID,
+		UserId:      card.UserId,
+		CardId:      card.CardId,
+		CardOrderId: card.CardOrderId,
+		CardNumber:  u.data.decrypt(card.CardNumber),
+		ProductId:   card.ProductId,
+		Status:      card.Status,
+		Main:        card.Main,
+		Fee:         card.Fee,
+		FeeRewardId: card.FeeRewardId,
+		CreatedAt:   card.CreatedAt,
+		UpdatedAt:   card.UpdatedAt,
+	}
+}
+
+// GetUserCardsByUserId .
+func (u *UserRepo) GetUserCardsByUserId(userId uint64, status ...string) ([]*biz.UserCard, error) {
+	var cards []*UserCard
+	res := make([]*biz.UserCard, 0)
+	if err := u.data.db.Table("user_card").Where("user_id=?", userId).Where("status in (?)", status).Order("id asc").Find(&cards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER CARD ERROR", err.Error())
+	}
+
+	for _, card := range cards {
+		res = append(res, u.toBizUserCard(card))
+	}
+
+	return res, nil
+}
+
+// GetUserCardCounts 用户未结束的卡片数量
+func (u *UserRepo) GetUserCardCounts(userIds ...uint64) (map[uint64]int64, error) {
+	var counts []*struct {
+		UserId uint64
+		Total  int64
+	}
+	res := make(map[uint64]int64, 0)
+	if 0 >= len(userIds) {
+		return res, nil
+	}
+
+	if err := u.data.db.Table("user_card").Where("user_id in (?)", userIds).Where("status in (?)", biz.UserCardCurrentStatus).
+		Select("user_id, COUNT(*) as total").Group("user_id").Find(&counts).Error; err != nil {
+		return nil, errors.New(500, "USER CARD ERROR", err.Error())
+	}
+
+	for _, v := range counts {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
+// GetUserCards .
+func (u *UserRepo) GetUserCards(b *biz.Pagination, userId uint64, status string) ([]*biz.UserCard, error, int64) {
+	var (
+		cards []*UserCard
+		count int64
+	)
+	res := make([]*biz.UserCard, 0)
+
+	instance := u.data.db.Table("user_card")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&cards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "USER CARD ERROR", err.Error()), 0
+	}
+
+	for _, card := range cards {
+		res = append(res, u.toBizUserCard(card))
+	}
+
+	return res, nil, count
+}
+
+// CreateUserCardApply 扣开卡费并新建卡片，主卡同步修改 user 表为开卡中
+func (u *UserRepo) CreateUserCardApply(ctx context.Context, c *biz.UserCard, amount float64) error {
+	updates := map[string]interface{}{
+		"amount":     gorm.Expr("amount - ?", amount),
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	}
+	instance := u.data.DB(ctx).Table("user").Where("id=?", c.UserId).Where("amount>=?", amount)
+	if 1 == c.Main {
+		instance = instance.Where("card_order_id=?", "no")
+		updates["card_order_id"] = "do"
+	}
+
+	res := instance.Updates(updates)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = c.UserId
+	reward.Amount = amount
+	reward.Reason = 3 // 给我分红的理由
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	c.Fee = amount
+	c.FeeRewardId = reward.ID
+	return u.CreateUserCard(ctx, c, "apply")
+}
+
+// UpdateCardOpenBack 非主卡开卡失败，只退开卡费
+func (u *UserRepo) UpdateCardOpenBack(ctx context.Context, userId uint64, amount float64) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = userId
+	reward.Amount = amount
+	reward.Reason = 7 // 给我分红的理由
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+// SetCardProductUserCardMax .
+func (u *UserRepo) SetCardProductUserCardMax(ctx context.Context, productId string, userCardMax uint64) error {
+	res := u.data.DB(ctx).Table("card_product").Where("product_id=?", productId).
+		Updates(map[string]interface{}{
+			"user_card_max": userCardMax,
+			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_PRODUCT_ERROR", "产品信息修改失败")
+	}
+
+	return nil
+}
+
+// GetUserRewardCardFee 用户最近一条还没有对应卡片的开卡扣费记录
+func (u *UserRepo) GetUserRewardCardFee(userId uint64) (*biz.Reward, error) {
+	var reward Reward
+	if err := u.data.db.Table("reward").Where("user_id=?", userId).Where("reason=?", 3).
+		Where("NOT EXISTS (SELECT 1 FROM user_card WHERE user_card.fee_reward_id=reward.id)").
+		Order("id desc").First(&reward).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return &biz.Reward{
+		ID:        reward.ID,
+		UserId:    reward.UserId,
+		Amount:    reward.Amount,
+		Reason:    reward.Reason,
+		CreatedAt: reward.CreatedAt,
+		UpdatedAt: reward.UpdatedAt,
+		Address:   reward.Address,
+		One:       reward.One,
+	}, nil
+}
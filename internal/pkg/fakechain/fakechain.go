@@ -0,0 +1,95 @@
+// Package fakechain 用 go-ethereum 模拟链代替 rpc 节点，用于集成测试。
+//
+// 用法：
+//
+//	node := fakechain.NewNode(core.GenesisAlloc{addr: {Balance: balance}})
+//	defer node.Stop()
+//	pool, _, _ := rpcpool.NewPoolWithDialer(c, fakechain.Dialer(map[string]*fakechain.Node{"node0": node}), logger)
+//	// conf.Chain.ChainId = fakechain.ChainId，networks 的 rpc_urls 填 map 的 key
+//
+// 每个 Node 是独立的链，同一个 alloc 建多个 Node 可以模拟块高不一致的节点。
+package fakechain
+
+import (
+	"cardbinance/internal/pkg/rpcpool"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ChainId 模拟链的链id
+const ChainId = 1337
+
+var ErrNodeDown = errors.New("fakechain: node down")
+
+// Node 单个模拟节点，实现 rpcpool.Backend
+type Node struct {
+	*backends.SimulatedBackend
+
+	mu          sync.Mutex
+	failReceipt bool
+}
+
+func NewNode(alloc core.GenesisAlloc) *Node {
+	return &Node{SimulatedBackend: backends.NewSimulatedBackend(alloc, 8000000)}
+}
+
+// FailReceipt 为 true 时查回执返回错误，模拟节点查询到一半出错
+func (n *Node) FailReceipt(fail bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.failReceipt = fail
+}
+
+// Mine 出 count 个块，第一个块打包已发送的交易
+func (n *Node) Mine(count int) {
+	for i := 0; i < count; i++ {
+		n.Commit()
+	}
+}
+
+func (n *Node) ChainID(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(ChainId), nil
+}
+
+func (n *Node) BlockNumber(ctx context.Context) (uint64, error) {
+	return n.Blockchain().CurrentBlock().NumberU64(), nil
+}
+
+func (n *Node) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	n.mu.Lock()
+	fail := n.failReceipt
+	n.mu.Unlock()
+	if fail {
+		return nil, ErrNodeDown
+	}
+
+	return n.SimulatedBackend.TransactionReceipt(ctx, txHash)
+}
+
+// Close 连接池出错时会关闭连接，模拟链保留状态，用 Stop 释放
+func (n *Node) Close() {}
+
+// Stop 停止模拟链
+func (n *Node) Stop() {
+	_ = n.SimulatedBackend.Close()
+}
+
+// Dialer 按 url 返回对应的模拟节点
+func Dialer(nodes map[string]*Node) rpcpool.Dialer {
+	return func(ctx context.Context, url string) (rpcpool.Backend, error) {
+		n, ok := nodes[url]
+		if !ok {
+			return nil, fmt.Errorf("fakechain: unknown node %s", url)
+		}
+		return n, nil
+	}
+}
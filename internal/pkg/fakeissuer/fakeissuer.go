@@ -0,0 +1,882 @@
+// Package fakeissuer 本地模拟 ispay 发卡接口，用于联调和集成测试。
+//
+// 用法：
+//
+//	fake := fakeissuer.NewServer("322338", "sign-key")
+//	ts := httptest.NewServer(fake)
+//	defer ts.Close()
+//	// conf.CardIssuer.BaseUrl = ts.URL
+package fakeissuer
+
+import (
+	"bytes"
+	"cardbinance/internal/data/ispay"
+	"cardbinance/internal/pkg/middleware/callback"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	CardStatusPending   = "PENDING"
+	CardStatusActive    = "ACTIVE"
+	CardStatusFailed    = "FAILED"
+	CardStatusFrozen    = "FROZEN"
+	CardStatusCancelled = "CANCELLED"
+
+	HolderStatusPending  = "pending"
+	HolderStatusActive   = "active"
+	HolderStatusRejected = "rejected"
+)
+
+type Card struct {
+	CardId       string `json:"cardId"`
+	CardOrderId  string `json:"cardOrderId"`
+	Pan          string `json:"pan"`
+	CardStatus   string `json:"cardStatus"`
+	HolderId     string `json:"holderId"`
+	ProductId    string `json:"productId"`
+	CardAmount   string `json:"cardAmount"`
+	CardCurrency string `json:"cardCurrency"`
+	DailyLimit   string `json:"dailyLimit"`
+	MonthlyLimit string `json:"monthlyLimit"`
+	CreateTime   string `json:"createTime"`
+}
+
+type Recharge struct {
+	OrderId       string `json:"orderId"`
+	ReferenceCode string `json:"referenceCode"`
+	CardId        string `json:"cardId"`
+	Amount        string `json:"amount"`
+	Status        string `json:"status"`
+}
+
+type Transaction struct {
+	TransactionId     string `json:"transactionId"`
+	CardId            string `json:"cardId"`
+	TransactionType   string `json:"transactionType"`
+	TransactionStatus string `json:"transactionStatus"`
+	Amount            string `json:"amount"`
+	Currency          string `json:"currency"`
+	MerchantName      string `json:"merchantName"`
+	Mcc               string `json:"mcc"`
+	TransactionTime   string `json:"transactionTime"`
+}
+
+type Holder struct {
+	HolderId    string `json:"holderId"`
+	ProductId   string `json:"productId"`
+	Email       string `json:"email"`
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+	BirthDate   string `json:"birthDate"`
+	CountryCode string `json:"countryCode"`
+	PhoneNumber string `json:"phoneNumber"`
+	Status      string `json:"status"`
+}
+
+type Product struct {
+	ProductId     string   `json:"productId"`
+	ProductName   string   `json:"productName"`
+	ModeType      string   `json:"modeType"`
+	CardBin       string   `json:"cardBin"`
+	CardForm      []string `json:"cardForm"`
+	MaxCardQuota  int      `json:"maxCardQuota"`
+	CardScheme    string   `json:"cardScheme"`
+	CardCurrency  []string `json:"cardCurrency"`
+	CreateTime    string   `json:"createTime"`
+	UpdateTime    string   `json:"updateTime"`
+	ProductStatus string   `json:"productStatus"`
+}
+
+// Server 模拟发卡方，实现 http.Handler
+type Server struct {
+	merchantId string
+	signKey    string
+
+	// CallbackUrl 回调地址，例如 http://127.0.0.1:8000/api/admin_dhb/callback
+	CallbackUrl string
+	// NewCardStatus 新开卡片的初始状态，默认 PENDING
+	NewCardStatus string
+	// NewHolderStatus 新建持卡人的初始状态，默认 pending
+	NewHolderStatus string
+
+	mu           sync.Mutex
+	seq          uint64
+	cards        map[string]*Card
+	orders       map[string]*Card // key 建卡 referenceCode
+	recharge     map[string]*Recharge
+	holders      map[string]*Holder
+	holderOrders map[string]*Holder // key 提交持卡人 referenceCode
+	txs          []*Transaction
+	products     []*Product
+	mux          *http.ServeMux
+}
+
+func NewServer(merchantId, signKey string) *Server {
+	s := &Server{
+		merchantId:      merchantId,
+		signKey:         signKey,
+		NewCardStatus:   CardStatusPending,
+		NewHolderStatus: HolderStatusPending,
+		seq:             100000,
+		cards:           make(map[string]*Card, 0),
+		orders:          make(map[string]*Card, 0),
+		recharge:        make(map[string]*Recharge, 0),
+		holders:         make(map[string]*Holder, 0),
+		holderOrders:    make(map[string]*Holder, 0),
+		products: []*Product{{
+			ProductId:     "1001",
+			ProductName:   "fake virtual card",
+			ModeType:      "SHARE",
+			CardBin:       "489607",
+			CardForm:      []string{"VIRTUAL"},
+			MaxCardQuota:  1000,
+			CardScheme:    "VISA",
+			CardCurrency:  []string{"USD"},
+			ProductStatus: "ENABLED",
+		}},
+		mux: http.NewServeMux(),
+	}
+
+	// 发卡方接口
+	s.mux.HandleFunc("/vcc/api/v1/cards/create", s.handleCardCreate)
+	s.mux.HandleFunc("/vcc/api/v1/cards/order/query", s.handleCardOrderQuery)
+	s.mux.HandleFunc("/vcc/api/v1/cards/info", s.handleCardInfo)
+	s.mux.HandleFunc("/vcc/api/v1/cards/recharge", s.handleCardRecharge)
+	s.mux.HandleFunc("/vcc/api/v1/cards/freeze", s.handleCardOperate(CardStatusActive, CardStatusFrozen))
+	s.mux.HandleFunc("/vcc/api/v1/cards/unfreeze", s.handleCardOperate(CardStatusFrozen, CardStatusActive))
+	s.mux.HandleFunc("/vcc/api/v1/cards/cancel", s.handleCardOperate("", CardStatusCancelled))
+	s.mux.HandleFunc("/vcc/api/v1/cards/update", s.handleCardOperate("", ""))
+	s.mux.HandleFunc("/vcc/api/v1/cards/transactions", s.handleTransactions)
+	s.mux.HandleFunc("/vcc/api/v1/cards/holders/query", s.handleHolderQuery)
+	s.mux.HandleFunc("/vcc/api/v1/cards/holders/create", s.handleHolderCreate)
+	s.mux.HandleFunc("/vcc/api/v1/cards/holders/order/query", s.handleHolderOrderQuery)
+	s.mux.HandleFunc("/vcc/api/v1/cards/products/all", s.handleProducts)
+
+	// 控制接口，供 cmd/fakeissuer 从外部编排状态
+	s.mux.HandleFunc("/fake/holders", s.handleFakeHolder)
+	s.mux.HandleFunc("/fake/holders/status", s.handleFakeHolderStatus)
+	s.mux.HandleFunc("/fake/cards", s.handleFakeCards)
+	s.mux.HandleFunc("/fake/cards/status", s.handleFakeCardStatus)
+	s.mux.HandleFunc("/fake/recharges", s.handleFakeRecharges)
+	s.mux.HandleFunc("/fake/transactions", s.handleFakeTransactions)
+	s.mux.HandleFunc("/fake/callback", s.handleFakeCallback)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) nextId() string {
+	s.seq++
+	return strconv.FormatUint(s.seq, 10)
+}
+
+// AddHolder 直接新增一个持卡人，返回 holderId
+func (s *Server) AddHolder(status string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	holder := &Holder{HolderId: s.nextId(), Status: status}
+	s.holders[holder.HolderId] = holder
+	return holder.HolderId
+}
+
+// SetHolderStatus 修改持卡人状态 pending / active / rejected
+func (s *Server) SetHolderStatus(holderId, status string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	holder, ok := s.holders[holderId]
+	if !ok {
+		return false
+	}
+	holder.Status = status
+	return true
+}
+
+// SetCardStatus 修改卡片状态 PENDING / ACTIVE / FAILED
+func (s *Server) SetCardStatus(cardId, status string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	card, ok := s.cards[cardId]
+	if !ok {
+		return false
+	}
+	card.CardStatus = status
+	return true
+}
+
+// Card 查询卡片当前状态
+func (s *Server) Card(cardId string) (Card, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	card, ok := s.cards[cardId]
+	if !ok {
+		return Card{}, false
+	}
+	return *card, true
+}
+
+// Cards 全部卡片
+func (s *Server) Cards() []Card {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]Card, 0, len(s.cards))
+	for _, v := range s.cards {
+		res = append(res, *v)
+	}
+	return res
+}
+
+// Recharges 全部充值订单
+func (s *Server) Recharges() []Recharge {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]Recharge, 0, len(s.recharge))
+	for _, v := range s.recharge {
+		res = append(res, *v)
+	}
+	return res
+}
+
+// AddTransaction 新增交易，transactionId 已存在时只更新状态，例如授权转结算
+func (s *Server) AddTransaction(tx Transaction) (Transaction, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range s.txs {
+		if "" != tx.TransactionId && v.TransactionId == tx.TransactionId {
+			settled := s.settled(v)
+			v.TransactionStatus = tx.TransactionStatus
+			if "" != tx.TransactionType {
+				v.TransactionType = tx.TransactionType
+			}
+			if !settled {
+				s.settle(v)
+			}
+			return *v, true
+		}
+	}
+
+	if _, ok := s.cards[tx.CardId]; !ok {
+		return Transaction{}, false
+	}
+
+	if "" == tx.TransactionId {
+		tx.TransactionId = "TX" + s.nextId()
+	}
+	if "" == tx.TransactionType {
+		tx.TransactionType = "AUTH"
+	}
+	if "" == tx.TransactionStatus {
+		tx.TransactionStatus = "SUCCESS"
+	}
+	if "" == tx.Currency {
+		tx.Currency = "USD"
+	}
+	if "" == tx.TransactionTime {
+		tx.TransactionTime = time.Now().In(time.FixedZone("UTC+8", 8*3600)).Format("2006-01-02 15:04:05")
+	}
+	s.txs = append(s.txs, &tx)
+	s.settle(&tx)
+
+	return tx, true
+}
+
+func (s *Server) settled(tx *Transaction) bool {
+	return "SUCCESS" == tx.TransactionStatus && ("SETTLE" == tx.TransactionType || "REFUND" == tx.TransactionType)
+}
+
+// settle 结算扣卡内余额，退款加回
+func (s *Server) settle(tx *Transaction) {
+	card, ok := s.cards[tx.CardId]
+	if !ok || !s.settled(tx) {
+		return
+	}
+
+	cardAmount, _ := strconv.ParseFloat(card.CardAmount, 64)
+	amount, _ := strconv.ParseFloat(tx.Amount, 64)
+	if "SETTLE" == tx.TransactionType {
+		amount = -amount
+	}
+	card.CardAmount = strconv.FormatFloat(cardAmount+amount, 'f', -1, 64)
+}
+
+// SendCallback 向 CallbackUrl 推送 vcc.* 事件，用 signKey 签名
+func (s *Server) SendCallback(ctx context.Context, eventType string, data interface{}) error {
+	if "" == s.CallbackUrl {
+		return fmt.Errorf("fakeissuer: callback url empty")
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	eventId := s.nextId()
+	s.mu.Unlock()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"version":   "1.0",
+		"eventName": eventType,
+		"eventType": eventType,
+		"eventId":   eventId,
+		"sourceId":  s.merchantId,
+		"data":      json.RawMessage(dataBytes),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.CallbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(callback.HeaderTimestamp, timestamp)
+	req.Header.Set(callback.HeaderSign, callback.Sign(s.signKey, timestamp, body))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("fakeissuer: callback status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
+
+// decode 解析请求并用 GenerateSign 校验签名
+func (s *Server) decode(r *http.Request) (map[string]interface{}, error) {
+	params := make(map[string]interface{}, 0)
+	if "GET" == r.Method {
+		for k, v := range r.URL.Query() {
+			params[k] = v[0]
+		}
+	} else {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber() // 数字保持原样，避免 float64 科学计数法导致签名不一致
+		if err := decoder.Decode(&params); err != nil {
+			return nil, fmt.Errorf("invalid json: %v", err)
+		}
+	}
+
+	sign, _ := params["sign"].(string)
+	if "" == sign || sign != ispay.GenerateSign(params, s.signKey) {
+		return nil, fmt.Errorf("sign error")
+	}
+
+	if merchantId := fmt.Sprintf("%v", params["merchantId"]); merchantId != s.merchantId {
+		return nil, fmt.Errorf("merchant error: %s", merchantId)
+	}
+
+	return params, nil
+}
+
+func (s *Server) reply(w http.ResponseWriter, code int, msg string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"code": code,
+		"msg":  msg,
+		"data": data,
+	})
+}
+
+// setSpendRule 请求带 cardSpendRule 的按请求修改限额
+func setSpendRule(card *Card, params map[string]interface{}) {
+	rule, ok := params["cardSpendRule"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if v, ok := rule["dailyLimit"]; ok {
+		card.DailyLimit = str(v)
+	}
+	if v, ok := rule["monthlyLimit"]; ok {
+		card.MonthlyLimit = str(v)
+	}
+}
+
+func str(v interface{}) string {
+	if nil == v {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func (s *Server) handleCardCreate(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 同一个 referenceCode 只建一张卡
+	referenceCode := str(params["referenceCode"])
+	if card, ok := s.orders[referenceCode]; ok && "" != referenceCode {
+		s.reply(w, 200, "success", cardOrder(card))
+		return
+	}
+
+	holderId := str(params["cardholderId"])
+	holder, ok := s.holders[holderId]
+	if !ok {
+		s.reply(w, 500, "cardholder not found", nil)
+		return
+	}
+	if HolderStatusActive != holder.Status {
+		s.reply(w, 500, "cardholder not active", nil)
+		return
+	}
+
+	cardId := s.nextId()
+	card := &Card{
+		CardId:       cardId,
+		CardOrderId:  "CO" + cardId,
+		Pan:          "4896070000" + cardId,
+		CardStatus:   s.NewCardStatus,
+		HolderId:     holderId,
+		ProductId:    str(params["cardProductId"]),
+		CardAmount:   str(params["cardAmount"]),
+		CardCurrency: str(params["cardCurrency"]),
+		CreateTime:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+	setSpendRule(card, params)
+	s.cards[cardId] = card
+	if "" != referenceCode {
+		s.orders[referenceCode] = card
+	}
+
+	s.reply(w, 200, "success", cardOrder(card))
+}
+
+func cardOrder(card *Card) map[string]interface{} {
+	return map[string]interface{}{
+		"cardId":      card.CardId,
+		"cardOrderId": card.CardOrderId,
+		"createTime":  card.CreateTime,
+		"cardStatus":  card.CardStatus,
+		"orderStatus": "PROCESSING",
+	}
+}
+
+// handleCardOrderQuery 按建卡 referenceCode 查询，没有订单返回 404
+func (s *Server) handleCardOrderQuery(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	card, ok := s.orders[str(params["referenceCode"])]
+	if !ok {
+		s.reply(w, 404, "card order not found", nil)
+		return
+	}
+
+	s.reply(w, 200, "success", cardOrder(card))
+}
+
+// handleCardRecharge 同一个 referenceCode 只生成一笔充值订单
+func (s *Server) handleCardRecharge(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	referenceCode := str(params["referenceCode"])
+	if "" == referenceCode {
+		s.reply(w, 500, "referenceCode empty", nil)
+		return
+	}
+
+	recharge, ok := s.recharge[referenceCode]
+	if !ok {
+		card, okCard := s.cards[str(params["cardId"])]
+		if !okCard || CardStatusActive != card.CardStatus {
+			s.reply(w, 500, "card not active", nil)
+			return
+		}
+
+		recharge = &Recharge{
+			OrderId:       "RO" + s.nextId(),
+			ReferenceCode: referenceCode,
+			CardId:        card.CardId,
+			Amount:        str(params["amount"]),
+			Status:        "PROCESSING",
+		}
+		s.recharge[referenceCode] = recharge
+
+		cardAmount, _ := strconv.ParseFloat(card.CardAmount, 64)
+		amount, _ := strconv.ParseFloat(recharge.Amount, 64)
+		card.CardAmount = strconv.FormatFloat(cardAmount+amount, 'f', -1, 64)
+	}
+
+	s.reply(w, 200, "success", recharge)
+}
+
+// handleCardOperate from 为空不校验原状态，to 为空不修改状态
+func (s *Server) handleCardOperate(from, to string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := s.decode(r)
+		if err != nil {
+			s.reply(w, 401, err.Error(), nil)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		card, ok := s.cards[str(params["cardId"])]
+		if !ok {
+			s.reply(w, 500, "card not found", nil)
+			return
+		}
+		if CardStatusCancelled == card.CardStatus || ("" != from && from != card.CardStatus) {
+			s.reply(w, 500, "card status error", nil)
+			return
+		}
+		if "" != to {
+			card.CardStatus = to
+		}
+		setSpendRule(card, params)
+
+		balance := "0"
+		if CardStatusCancelled == to {
+			balance = card.CardAmount
+		}
+
+		s.reply(w, 200, "success", map[string]interface{}{
+			"cardId":     card.CardId,
+			"cardStatus": card.CardStatus,
+			"balance":    balance,
+		})
+	}
+}
+
+// handleTransactions 按交易时间过滤后分页
+func (s *Server) handleTransactions(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	startTime, endTime := str(params["startTime"]), str(params["endTime"])
+	rows := make([]*Transaction, 0)
+	for _, v := range s.txs {
+		if ("" != startTime && v.TransactionTime < startTime) || ("" != endTime && v.TransactionTime > endTime) {
+			continue
+		}
+		rows = append(rows, v)
+	}
+
+	total := len(rows)
+	pageNum, _ := strconv.Atoi(str(params["pageNum"]))
+	pageSize, _ := strconv.Atoi(str(params["pageSize"]))
+	if 0 >= pageNum {
+		pageNum = 1
+	}
+	if 0 >= pageSize {
+		pageSize = 10
+	}
+	start := (pageNum - 1) * pageSize
+	if start > total {
+		start = total
+	}
+	end := start + pageSize
+	if end > total {
+		end = total
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"total": total,
+		"rows":  rows[start:end],
+		"code":  200,
+		"msg":   "success",
+	})
+}
+
+func (s *Server) handleCardInfo(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	card, ok := s.cards[str(params["cardId"])]
+	if !ok {
+		s.reply(w, 500, "card not found", nil)
+		return
+	}
+
+	pan := ""
+	if CardStatusActive == card.CardStatus {
+		pan = card.Pan
+	}
+
+	s.reply(w, 200, "success", map[string]interface{}{
+		"cardId":     card.CardId,
+		"pan":        pan,
+		"cardStatus": card.CardStatus,
+		"cardAmount": card.CardAmount,
+		"holder": map[string]interface{}{
+			"holderId": card.HolderId,
+		},
+	})
+}
+
+func (s *Server) handleHolderQuery(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	holder, ok := s.holders[str(params["holderId"])]
+	if !ok {
+		s.reply(w, 500, "cardholder not found", nil)
+		return
+	}
+
+	s.reply(w, 200, "success", holder)
+}
+
+func (s *Server) handleHolderCreate(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 同一个 referenceCode 只建一个持卡人
+	referenceCode := str(params["referenceCode"])
+	if holder, ok := s.holderOrders[referenceCode]; ok && "" != referenceCode {
+		s.reply(w, 200, "success", holder)
+		return
+	}
+
+	holder := &Holder{
+		HolderId:    s.nextId(),
+		ProductId:   str(params["productId"]),
+		Email:       str(params["email"]),
+		FirstName:   str(params["firstName"]),
+		LastName:    str(params["lastName"]),
+		BirthDate:   str(params["birthDate"]),
+		CountryCode: str(params["countryCode"]),
+		PhoneNumber: str(params["phoneNumber"]),
+		Status:      s.NewHolderStatus,
+	}
+	s.holders[holder.HolderId] = holder
+	if "" != referenceCode {
+		s.holderOrders[referenceCode] = holder
+	}
+
+	s.reply(w, 200, "success", holder)
+}
+
+// handleHolderOrderQuery 按提交持卡人 referenceCode 查询，没有订单返回 404
+func (s *Server) handleHolderOrderQuery(w http.ResponseWriter, r *http.Request) {
+	params, err := s.decode(r)
+	if err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	holder, ok := s.holderOrders[str(params["referenceCode"])]
+	if !ok {
+		s.reply(w, 404, "cardholder order not found", nil)
+		return
+	}
+
+	s.reply(w, 200, "success", holder)
+}
+
+func (s *Server) handleProducts(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.decode(r); err != nil {
+		s.reply(w, 401, err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"total": len(s.products),
+		"rows":  s.products,
+		"code":  200,
+		"msg":   "success",
+	})
+}
+
+type fakeStatusRequest struct {
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+func (s *Server) handleFakeHolder(w http.ResponseWriter, r *http.Request) {
+	var req fakeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if "" == req.Status {
+		req.Status = HolderStatusActive
+	}
+
+	s.reply(w, 200, "success", map[string]string{"holderId": s.AddHolder(req.Status)})
+}
+
+func (s *Server) handleFakeHolderStatus(w http.ResponseWriter, r *http.Request) {
+	var req fakeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.SetHolderStatus(req.Id, req.Status) {
+		http.Error(w, "holder not found", http.StatusNotFound)
+		return
+	}
+
+	// 配置了回调地址时同步推送审核结果
+	if "" != s.CallbackUrl {
+		eventType := "vcc.cardholder.create.success"
+		if HolderStatusRejected == req.Status {
+			eventType = "vcc.cardholder.create.fail"
+		}
+		if HolderStatusPending != req.Status {
+			if err := s.SendCallback(r.Context(), eventType, map[string]string{"merchantId": s.merchantId, "holderId": req.Id, "status": req.Status}); err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+		}
+	}
+
+	s.reply(w, 200, "success", nil)
+}
+
+func (s *Server) handleFakeCards(w http.ResponseWriter, r *http.Request) {
+	s.reply(w, 200, "success", s.Cards())
+}
+
+func (s *Server) handleFakeRecharges(w http.ResponseWriter, r *http.Request) {
+	s.reply(w, 200, "success", s.Recharges())
+}
+
+func (s *Server) handleFakeTransactions(w http.ResponseWriter, r *http.Request) {
+	if "GET" == r.Method {
+		s.mu.Lock()
+		res := make([]Transaction, 0, len(s.txs))
+		for _, v := range s.txs {
+			res = append(res, *v)
+		}
+		s.mu.Unlock()
+
+		s.reply(w, 200, "success", res)
+		return
+	}
+
+	var req Transaction
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tx, ok := s.AddTransaction(req)
+	if !ok {
+		http.Error(w, "card not found", http.StatusNotFound)
+		return
+	}
+
+	s.reply(w, 200, "success", tx)
+}
+
+func (s *Server) handleFakeCardStatus(w http.ResponseWriter, r *http.Request) {
+	var req fakeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !s.SetCardStatus(req.Id, strings.ToUpper(req.Status)) {
+		http.Error(w, "card not found", http.StatusNotFound)
+		return
+	}
+
+	// 配置了回调地址时同步推送卡片结果
+	if card, ok := s.Card(req.Id); ok && "" != s.CallbackUrl && CardStatusPending != card.CardStatus {
+		eventType := "vcc.card.activated"
+		if CardStatusFailed == card.CardStatus {
+			eventType = "vcc.card.create.fail"
+		}
+		if err := s.SendCallback(r.Context(), eventType, map[string]string{"merchantId": s.merchantId, "cardId": card.CardId, "cardNumber": card.Pan}); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+
+	s.reply(w, 200, "success", nil)
+}
+
+func (s *Server) handleFakeCallback(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		EventType string          `json:"eventType"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.SendCallback(r.Context(), req.EventType, req.Data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	s.reply(w, 200, "success", nil)
+}
@@ -0,0 +1,877 @@
+// Package fakerepo 内存里的 biz.UserRepo 和 biz.CardProvider，用于 biz 和 service 的测试。
+//
+// 用法：
+//
+//	repo := fakerepo.New()
+//	card := fakerepo.NewCard()
+//	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, card, chain, logger)
+//
+// 只实现测试用到的方法，其余方法调用会 panic。
+package fakerepo
+
+import (
+	"cardbinance/internal/biz"
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Tx 内存仓库没有事务，直接执行
+type Tx struct{}
+
+func (Tx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// Repo 内存里的 UserRepo，只实现用到的方法，其余方法调用会 panic
+type Repo struct {
+	biz.UserRepo
+
+	mu          sync.Mutex
+	withdraws   map[uint64]*biz.Withdraw
+	withdrawTxs []*biz.WithdrawTx
+	nonces      map[string]uint64
+
+	users          map[uint64]*biz.User
+	cards          map[uint64]*biz.UserCard
+	products       map[string]*biz.CardProductInfo
+	defaults       map[uint64]string // key vipTwo，value 产品id
+	recommends     map[uint64]*biz.UserRecommend
+	cardRewards    []Reward
+	feeRewards     []*biz.Reward     // 开卡扣费 reason=3 记录
+	failCardStatus map[uint64]string // 这些卡片改为对应状态时返回错误
+	cardRecords    []biz.CardRecordType
+	events         []*biz.CardCallbackEvent
+	journals       []*biz.IssuerJournal
+	cursors        map[string]uint64
+	deposits       []*biz.EthDeposit
+}
+
+// Reward 开卡分红记录
+type Reward struct {
+	UserId  uint64
+	Amount  float64
+	Vip     uint64
+	Address string
+}
+
+func New() *Repo {
+	return &Repo{
+		withdraws:      make(map[uint64]*biz.Withdraw, 0),
+		nonces:         make(map[string]uint64, 0),
+		users:          make(map[uint64]*biz.User, 0),
+		cards:          make(map[uint64]*biz.UserCard, 0),
+		products:       make(map[string]*biz.CardProductInfo, 0),
+		defaults:       make(map[uint64]string, 0),
+		recommends:     make(map[uint64]*biz.UserRecommend, 0),
+		failCardStatus: make(map[uint64]string, 0),
+		cursors:        make(map[string]uint64, 0),
+	}
+}
+
+// ErrUpdate 条件更新没有命中，和数据库 RowsAffected 为 0 一样
+var ErrUpdate = errors.New(500, "UPDATE_ERROR", "状态不符")
+
+func (r *Repo) AddWithdraw(w *biz.Withdraw) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.withdraws[w.ID] = w
+}
+
+func (r *Repo) SavedWithdraw(id uint64) biz.Withdraw {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return *r.withdraws[id]
+}
+
+func (r *Repo) GetWithdrawsByStatus(status string, limit int) ([]*biz.Withdraw, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.Withdraw, 0)
+	for _, w := range r.withdraws {
+		if status == w.Status {
+			tmp := *w
+			res = append(res, &tmp)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func (r *Repo) GetWithdrawById(id uint64) (*biz.Withdraw, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w, ok := r.withdraws[id]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *w
+	return &tmp, nil
+}
+
+func (r *Repo) update(id uint64, from string, fn func(w *biz.Withdraw)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w, ok := r.withdraws[id]
+	if !ok || from != w.Status {
+		return ErrUpdate
+	}
+	fn(w)
+	w.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Repo) UpdateWithdraw(ctx context.Context, id uint64, from []string, status string) (*biz.Withdraw, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w, ok := r.withdraws[id]
+	if !ok {
+		return nil, ErrUpdate
+	}
+	for _, v := range from {
+		if v == w.Status {
+			w.Status = status
+			w.UpdatedAt = time.Now()
+			tmp := *w
+			return &tmp, nil
+		}
+	}
+	return nil, ErrUpdate
+}
+
+func (r *Repo) UpdateWithdrawSent(ctx context.Context, id uint64, claimedAt time.Time, txHash string, nonce uint64, rawTx string) error {
+	r.mu.Lock()
+	w, ok := r.withdraws[id]
+	claimed := ok && "" == w.TxHash && w.UpdatedAt.Equal(claimedAt)
+	r.mu.Unlock()
+	if !claimed {
+		return ErrUpdate
+	}
+
+	return r.update(id, biz.WithdrawDoing, func(w *biz.Withdraw) {
+		w.Status = biz.WithdrawSent
+		w.TxHash = txHash
+		w.Nonce = nonce
+		w.RawTx = rawTx
+		w.BatchId = 0
+	})
+}
+
+func (r *Repo) UpdateWithdrawDoingTimeout(ctx context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var count int64
+	for _, w := range r.withdraws {
+		if biz.WithdrawDoing == w.Status && "" == w.TxHash && w.UpdatedAt.Before(before) {
+			w.Status = biz.WithdrawApproved
+			w.UpdatedAt = time.Now()
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *Repo) UpdateWithdrawReplaced(ctx context.Context, id uint64, txHash string, rawTx string) error {
+	return r.update(id, biz.WithdrawSent, func(w *biz.Withdraw) {
+		w.TxHash = txHash
+		w.RawTx = rawTx
+	})
+}
+
+func (r *Repo) UpdateWithdrawConfirmed(ctx context.Context, id uint64, status string, txHash string, gasUsed uint64, blockNumber uint64) error {
+	return r.update(id, biz.WithdrawSent, func(w *biz.Withdraw) {
+		w.Status = status
+		w.TxHash = txHash
+		w.GasUsed = gasUsed
+		w.BlockNumber = blockNumber
+	})
+}
+
+func (r *Repo) UpdateWithdrawRequeue(ctx context.Context, id uint64, from string) error {
+	return r.update(id, from, func(w *biz.Withdraw) {
+		w.Status = biz.WithdrawApproved
+		w.TxHash = ""
+		w.Nonce = 0
+		w.RawTx = ""
+	})
+}
+
+func (r *Repo) CreateWithdrawTx(ctx context.Context, wt *biz.WithdrawTx) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tmp := *wt
+	tmp.ID = uint64(len(r.withdrawTxs) + 1)
+	if tmp.CreatedAt.IsZero() {
+		tmp.CreatedAt = time.Now()
+	}
+	r.withdrawTxs = append(r.withdrawTxs, &tmp)
+	return nil
+}
+
+// GetWithdrawTxs 新的在前
+func (r *Repo) GetWithdrawTxs(withdrawId uint64, batchId uint64) ([]*biz.WithdrawTx, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.WithdrawTx, 0)
+	for i := len(r.withdrawTxs) - 1; i >= 0; i-- {
+		wt := r.withdrawTxs[i]
+		if (0 < batchId && batchId == wt.BatchId) || (0 == batchId && withdrawId == wt.WithdrawId) {
+			tmp := *wt
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+// ageWithdrawTxs 把已有交易的发出时间往前移，模拟交易卡住了一段时间
+func (r *Repo) AgeWithdrawTxs(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, wt := range r.withdrawTxs {
+		wt.CreatedAt = wt.CreatedAt.Add(-d)
+	}
+}
+
+func (r *Repo) GetWalletNonce(ctx context.Context, address string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.nonces[address], nil
+}
+
+func (r *Repo) SaveWalletNonce(ctx context.Context, address string, nonce uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nonces[address] = nonce
+	return nil
+}
+
+func (r *Repo) AddUser(u *biz.User, recommendCode string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[u.ID] = u
+	r.recommends[u.ID] = &biz.UserRecommend{UserId: u.ID, RecommendCode: recommendCode}
+}
+
+func (r *Repo) AddCard(c *biz.UserCard) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cards[c.ID] = c
+}
+
+// AddFeeReward 开卡扣费记录
+func (r *Repo) AddFeeReward(reward *biz.Reward) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.feeRewards = append(r.feeRewards, reward)
+}
+
+func (r *Repo) AddProduct(p *biz.CardProductInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.products[p.ProductId] = p
+}
+
+// AddProductDefault 区域默认产品
+func (r *Repo) AddProductDefault(vipTwo uint64, productId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.defaults[vipTwo] = productId
+}
+
+func (r *Repo) SavedUser(id uint64) biz.User {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return *r.users[id]
+}
+
+func (r *Repo) SavedCard(id uint64) biz.UserCard {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return *r.cards[id]
+}
+
+func (r *Repo) SavedRewards() []Reward {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Reward{}, r.cardRewards...)
+}
+
+func (r *Repo) GetUserById(userId uint64) (*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[userId]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *u
+	return &tmp, nil
+}
+
+func (r *Repo) GetAllUsers() ([]*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.User, 0, len(r.users))
+	for _, u := range r.users {
+		tmp := *u
+		res = append(res, &tmp)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+func (r *Repo) GetUserRecommendByUserId(userId uint64) (*biz.UserRecommend, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ur, ok := r.recommends[userId]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *ur
+	return &tmp, nil
+}
+
+func (r *Repo) GetCardProductByProductId(productId string) (*biz.CardProductInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.products[productId]
+	if !ok {
+		return nil, nil
+	}
+	tmp := *p
+	return &tmp, nil
+}
+
+// GetUsersWithoutUserCard 申请过开卡但没有卡片记录的用户
+func (r *Repo) GetUsersWithoutUserCard() ([]*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.User, 0)
+	for _, u := range r.users {
+		if "no" == u.CardOrderId {
+			continue
+		}
+		found := false
+		for _, c := range r.cards {
+			if u.ID == c.UserId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tmp := *u
+			res = append(res, &tmp)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// GetUserRewardCardFee 最近一条没有卡片关联的扣费记录
+func (r *Repo) GetUserRewardCardFee(userId uint64) (*biz.Reward, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.feeRewards) - 1; i >= 0; i-- {
+		reward := r.feeRewards[i]
+		if userId != reward.UserId {
+			continue
+		}
+		linked := false
+		for _, c := range r.cards {
+			if reward.ID == c.FeeRewardId {
+				linked = true
+				break
+			}
+		}
+		if !linked {
+			tmp := *reward
+			return &tmp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Repo) GetCardProductDefault(vipTwo uint64) (*biz.CardProductInfo, error) {
+	r.mu.Lock()
+	productId, ok := r.defaults[vipTwo]
+	r.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	return r.GetCardProductByProductId(productId)
+}
+
+func (r *Repo) GetUserCardsByUserId(userId uint64, status ...string) ([]*biz.UserCard, error) {
+	cards, err := r.GetUserCardsByStatus(status...)
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*biz.UserCard, 0)
+	for _, c := range cards {
+		if userId == c.UserId {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (r *Repo) GetUserCardsByStatus(status ...string) ([]*biz.UserCard, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.UserCard, 0)
+	for _, c := range r.cards {
+		for _, s := range status {
+			if s == c.Status {
+				tmp := *c
+				res = append(res, &tmp)
+				break
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// UpdateUserCardStatus 和数据库一样按原状态条件更新
+func (r *Repo) UpdateUserCardStatus(ctx context.Context, c *biz.UserCard, status string, remark string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.cards[c.ID]
+	if !ok || c.Status != stored.Status || status == r.failCardStatus[c.ID] {
+		return ErrUpdate
+	}
+	if "" != c.CardId {
+		stored.CardId = c.CardId
+	}
+	if "" != c.CardOrderId {
+		stored.CardOrderId = c.CardOrderId
+	}
+	if "" != c.CardNumber {
+		stored.CardNumber = c.CardNumber
+	}
+	if "" != c.ProductId {
+		stored.ProductId = c.ProductId
+	}
+	stored.Status = status
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (r *Repo) updateUser(userId uint64, check func(u *biz.User) bool, fn func(u *biz.User)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[userId]
+	if !ok || !check(u) {
+		return ErrUpdate
+	}
+	fn(u)
+	return nil
+}
+
+func (r *Repo) UpdateCardUserSubmitted(ctx context.Context, userId uint64, productId string, maxCardQuota uint64, referenceCode string) error {
+	return r.updateUser(userId, func(u *biz.User) bool {
+		return biz.CardHolderNone == u.CardUserStatus || biz.CardHolderRejected == u.CardUserStatus
+	}, func(u *biz.User) {
+		u.CardUserStatus = biz.CardHolderSubmitted
+		u.CardUserId = referenceCode
+		u.ProductId = productId
+		u.MaxCardQuota = maxCardQuota
+	})
+}
+
+func (r *Repo) UpdateCardUserStatus(ctx context.Context, userId uint64, from []string, status string, cardUserId string) error {
+	return r.updateUser(userId, func(u *biz.User) bool {
+		for _, v := range from {
+			if v == u.CardUserStatus {
+				return true
+			}
+		}
+		return false
+	}, func(u *biz.User) {
+		u.CardUserStatus = status
+		if "" != cardUserId {
+			u.CardUserId = cardUserId
+		}
+	})
+}
+
+func (r *Repo) UpdateCard(ctx context.Context, userId uint64, cardOrderId, card string) error {
+	return r.updateUser(userId, func(u *biz.User) bool { return "do" == u.CardOrderId }, func(u *biz.User) {
+		u.CardOrderId = cardOrderId
+		u.Card = card
+	})
+}
+
+func (r *Repo) UpdateCardNo(ctx context.Context, userId uint64, amount float64) error {
+	return r.updateUser(userId, func(u *biz.User) bool { return "no" != u.CardOrderId && "no" == u.CardNumber }, func(u *biz.User) {
+		u.CardOrderId = "no"
+		u.Card = "no"
+		u.Amount += amount
+	})
+}
+
+func (r *Repo) UpdateCardOpenBack(ctx context.Context, userId uint64, amount float64) error {
+	return r.updateUser(userId, func(u *biz.User) bool { return true }, func(u *biz.User) {
+		u.Amount += amount
+	})
+}
+
+func (r *Repo) UpdateCardSucces(ctx context.Context, userId uint64, cardNum string) error {
+	return r.updateUser(userId, func(u *biz.User) bool { return "no" == u.CardNumber }, func(u *biz.User) {
+		u.CardNumber = cardNum
+	})
+}
+
+func (r *Repo) CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cardRewards = append(r.cardRewards, Reward{UserId: userId, Amount: amount, Vip: vip, Address: address})
+	return nil
+}
+
+func (r *Repo) GetUserByCard(card string) (*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if card == u.Card {
+			tmp := *u
+			return &tmp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Repo) GetUserCardByCardId(cardId string) (*biz.UserCard, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range r.cards {
+		if cardId == c.CardId {
+			tmp := *c
+			return &tmp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Repo) InsertCardRecord(ctx context.Context, userId uint64, recordType biz.CardRecordType, remark string, code string, opt string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cardRecords = append(r.cardRecords, recordType)
+	return nil
+}
+
+// CreateCardCallbackEvent eventId 重复的不新增
+func (r *Repo) CreateCardCallbackEvent(ctx context.Context, e *biz.CardCallbackEvent) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.events {
+		if e.EventId == v.EventId {
+			return false, nil
+		}
+	}
+	tmp := *e
+	tmp.ID = uint64(len(r.events) + 1)
+	tmp.Status = "pending"
+	r.events = append(r.events, &tmp)
+	return true, nil
+}
+
+func (r *Repo) GetCardCallbackEventsPending(maxTimes uint64, limit int) ([]*biz.CardCallbackEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.CardCallbackEvent, 0)
+	for _, e := range r.events {
+		if ("pending" == e.Status || "fail" == e.Status) && e.Times < maxTimes && len(res) < limit {
+			tmp := *e
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil
+}
+
+func (r *Repo) UpdateCardCallbackEvent(ctx context.Context, id uint64, status string, remark string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if 0 >= id || int(id) > len(r.events) {
+		return ErrUpdate
+	}
+	e := r.events[id-1]
+	e.Status = status
+	e.Remark = remark
+	e.Times++
+	return nil
+}
+
+func (r *Repo) GetCardCallbackEvents(b *biz.Pagination, eventId string, status string) ([]*biz.CardCallbackEvent, error, int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]*biz.CardCallbackEvent, 0)
+	for _, e := range r.events {
+		if ("" == eventId || eventId == e.EventId) && ("" == status || status == e.Status) {
+			tmp := *e
+			res = append(res, &tmp)
+		}
+	}
+	return res, nil, int64(len(res))
+}
+
+func (r *Repo) SavedEvents() []biz.CardCallbackEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]biz.CardCallbackEvent, 0, len(r.events))
+	for _, e := range r.events {
+		res = append(res, *e)
+	}
+	return res
+}
+
+func (r *Repo) GetChainCursor(name string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cursors[name], nil
+}
+
+func (r *Repo) SaveChainCursor(ctx context.Context, name string, block uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cursors[name] = block
+	return nil
+}
+
+func (r *Repo) GetUserByAddresses(addresses ...string) (map[string]*biz.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make(map[string]*biz.User, 0)
+	for _, u := range r.users {
+		for _, address := range addresses {
+			if address == u.Address {
+				tmp := *u
+				res[address] = &tmp
+			}
+		}
+	}
+	return res, nil
+}
+
+// CreateEthDeposit tx hash + log index 重复时不插入，和唯一索引一样
+func (r *Repo) CreateEthDeposit(ctx context.Context, d *biz.EthDeposit) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.deposits {
+		if d.TxHash == v.TxHash && d.LogIndex == v.LogIndex {
+			return false, nil
+		}
+	}
+	tmp := *d
+	tmp.ID = uint64(len(r.deposits) + 1)
+	r.deposits = append(r.deposits, &tmp)
+	return true, nil
+}
+
+func (r *Repo) CreateEthUserRecordListByHash(ctx context.Context, record *biz.EthUserRecord) (*biz.EthUserRecord, error) {
+	err := r.updateUser(uint64(record.UserId), func(u *biz.User) bool { return true }, func(u *biz.User) {
+		u.Amount += float64(record.AmountTwo)
+	})
+	return record, err
+}
+
+func (r *Repo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error {
+	return r.updateUser(userId, func(u *biz.User) bool { return true }, func(u *biz.User) {
+		u.MyTotalAmount += amount
+	})
+}
+
+func (r *Repo) SavedDeposits() []biz.EthDeposit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]biz.EthDeposit, 0, len(r.deposits))
+	for _, d := range r.deposits {
+		res = append(res, *d)
+	}
+	return res
+}
+
+func (r *Repo) CreateIssuerJournal(ctx context.Context, j *biz.IssuerJournal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tmp := *j
+	r.journals = append(r.journals, &tmp)
+	return nil
+}
+
+// Card 发卡方，按持卡人返回开卡结果，按卡片id返回卡片信息，其余方法调用会 panic
+type Card struct {
+	biz.CardProvider
+
+	mu        sync.Mutex
+	Create    map[uint64]*biz.CreateCardResponse // key 持卡人id，调用前设置
+	CreateErr map[uint64]error
+	Created   int                                // 建卡请求次数
+	Orders    map[string]*biz.CreateCardResponse // key referenceCode，建卡成功时记录
+	OrderErr  error
+	Info      map[string]*biz.CardInfoResponse // key 卡片id
+	InfoErr   error
+
+	Holder        map[uint64]*biz.CreateCardholderResponse // key 用户id，调用前设置
+	HolderErr     map[uint64]error
+	HolderCreated []string                                 // 每次提交持卡人的 referenceCode
+	HolderOrders  map[string]*biz.CreateCardholderResponse // key referenceCode，提交成功时记录
+}
+
+func NewCard() *Card {
+	return &Card{
+		Create:    make(map[uint64]*biz.CreateCardResponse, 0),
+		CreateErr: make(map[uint64]error, 0),
+		Orders:    make(map[string]*biz.CreateCardResponse, 0),
+		Info:      make(map[string]*biz.CardInfoResponse, 0),
+
+		Holder:       make(map[uint64]*biz.CreateCardholderResponse, 0),
+		HolderErr:    make(map[uint64]error, 0),
+		HolderOrders: make(map[string]*biz.CreateCardholderResponse, 0),
+	}
+}
+
+func (f *Card) CreateCard(ctx context.Context, cardAmount uint64, cardholderId uint64, cardProductId uint64, referenceCode string) (*biz.CreateCardResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Created++
+	res := f.Create[cardholderId]
+	if nil != res && 200 == res.Code && "" != res.Data.CardID {
+		f.Orders[referenceCode] = res
+	}
+	return res, f.CreateErr[cardholderId]
+}
+
+// QueryCardOrder 没有记录的 referenceCode 返回 biz.CardOrderNotFound
+func (f *Card) QueryCardOrder(ctx context.Context, referenceCode string) (*biz.CreateCardResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if nil != f.OrderErr {
+		return nil, f.OrderErr
+	}
+	if res, ok := f.Orders[referenceCode]; ok {
+		return res, nil
+	}
+	return &biz.CreateCardResponse{Code: biz.CardOrderNotFound, Msg: "card order not found"}, nil
+}
+
+func (f *Card) CreateCardholder(ctx context.Context, productId uint64, user *biz.User, referenceCode string) (*biz.CreateCardholderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.HolderCreated = append(f.HolderCreated, referenceCode)
+	res := f.Holder[user.ID]
+	if nil != res && 200 == res.Code {
+		f.HolderOrders[referenceCode] = res
+	}
+	return res, f.HolderErr[user.ID]
+}
+
+// QueryCardHolderOrder 没有记录的 referenceCode 返回 biz.CardOrderNotFound
+func (f *Card) QueryCardHolderOrder(ctx context.Context, referenceCode string) (*biz.CreateCardholderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if res, ok := f.HolderOrders[referenceCode]; ok {
+		return res, nil
+	}
+	return &biz.CreateCardholderResponse{Code: biz.CardOrderNotFound, Msg: "cardholder order not found"}, nil
+}
+
+// QueryCardHolder 提交过的持卡人都在审核中
+func (f *Card) QueryCardHolder(ctx context.Context, holderId uint64, productId uint64) (*biz.QueryCardHolderResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, v := range f.HolderOrders {
+		if strconv.FormatUint(holderId, 10) == v.Data.HolderID {
+			res := &biz.QueryCardHolderResponse{Code: 200}
+			res.Data.HolderId = v.Data.HolderID
+			res.Data.Status = biz.CardHolderPending
+			return res, nil
+		}
+	}
+	return &biz.QueryCardHolderResponse{Code: 500, Msg: "cardholder not found"}, nil
+}
+
+func (f *Card) GetCardInfo(ctx context.Context, cardId string) (*biz.CardInfoResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if nil != f.InfoErr {
+		return nil, f.InfoErr
+	}
+	return f.Info[cardId], nil
+}
+
+// FailCardStatus 这张卡片改为 status 时返回错误，模拟落库失败
+func (r *Repo) FailCardStatus(id uint64, status string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failCardStatus[id] = status
+}
+
+func (r *Repo) SavedJournals() []biz.IssuerJournal {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := make([]biz.IssuerJournal, 0, len(r.journals))
+	for _, j := range r.journals {
+		res = append(res, *j)
+	}
+	return res
+}
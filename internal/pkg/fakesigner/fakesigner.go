@@ -0,0 +1,141 @@
+// Package fakesigner 本地模拟远程签名服务，用于联调和集成测试。
+//
+// 用法：
+//
+//	fake, _ := fakesigner.NewServer("", "token")
+//	ts := httptest.NewServer(fake)
+//	defer ts.Close()
+//	// conf.Signer.RemoteUrl = ts.URL
+//	// conf.Signer.RemoteAddress = fake.Address().Hex()
+package fakesigner
+
+import (
+	"cardbinance/internal/pkg/signer"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Server 用内存中的私钥签名，记录签过的交易
+type Server struct {
+	key   *ecdsa.PrivateKey
+	token string
+
+	mu     sync.Mutex
+	signed []*types.Transaction
+	Refuse bool // 为 true 时拒绝签名
+}
+
+// NewServer hexKey 为空时随机生成私钥，token 为空时不校验
+func NewServer(hexKey string, token string) (*Server, error) {
+	var (
+		key *ecdsa.PrivateKey
+		err error
+	)
+	if "" == hexKey {
+		key, err = crypto.GenerateKey()
+	} else {
+		key, err = crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	return &Server{key: key, token: token}, nil
+}
+
+// Address 签名地址
+func (s *Server) Address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+// Signed 签过的交易
+func (s *Server) Signed() []*types.Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]*types.Transaction, len(s.signed))
+	copy(res, s.signed)
+	return res
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case "GET" == r.Method && "/address" == r.URL.Path:
+		_ = json.NewEncoder(w).Encode(map[string]string{"address": s.Address().Hex()})
+	case "POST" == r.Method && "/sign" == r.URL.Path:
+		s.sign(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request) {
+	if "" != s.token && "Bearer "+s.token != r.Header.Get("Authorization") {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var req signer.SignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); nil != err {
+		s.reply(w, 1, "bad request: "+err.Error(), "")
+		return
+	}
+
+	if s.Refuse {
+		s.reply(w, 1, "refused", "")
+		return
+	}
+
+	if !strings.EqualFold(req.From, s.Address().Hex()) {
+		s.reply(w, 1, "unknown from", "")
+		return
+	}
+
+	chainId, ok := new(big.Int).SetString(req.ChainId, 10)
+	if !ok {
+		s.reply(w, 1, "bad chain id", "")
+		return
+	}
+
+	raw, err := hexutil.Decode(req.Tx)
+	if nil != err {
+		s.reply(w, 1, "bad tx: "+err.Error(), "")
+		return
+	}
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); nil != err {
+		s.reply(w, 1, "bad tx: "+err.Error(), "")
+		return
+	}
+
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainId), s.key)
+	if nil != err {
+		s.reply(w, 1, err.Error(), "")
+		return
+	}
+	signedRaw, err := signed.MarshalBinary()
+	if nil != err {
+		s.reply(w, 1, err.Error(), "")
+		return
+	}
+
+	s.mu.Lock()
+	s.signed = append(s.signed, signed)
+	s.mu.Unlock()
+
+	s.reply(w, 0, "", hexutil.Encode(signedRaw))
+}
+
+func (s *Server) reply(w http.ResponseWriter, code int, msg string, signedTx string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&signer.SignResponse{Code: code, Msg: msg, SignedTx: signedTx})
+}
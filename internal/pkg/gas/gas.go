@@ -0,0 +1,87 @@
+package gas
+
+import (
+	"cardbinance/internal/conf"
+	"errors"
+	"math/big"
+	"time"
+)
+
+var (
+	ErrPriceCap = errors.New("gas price above cap")
+	ErrLimitCap = errors.New("gas limit above cap")
+)
+
+var gwei = big.NewInt(1e9)
+
+// Policy 提现交易的 gas 上限和替换规则
+type Policy struct {
+	maxPrice     *big.Int
+	maxLimit     uint64
+	bumpPercent  uint64
+	replaceAfter time.Duration
+}
+
+func NewPolicy(c *conf.Chain) *Policy {
+	p := &Policy{
+		maxPrice:     new(big.Int).Mul(big.NewInt(10), gwei),
+		maxLimit:     200000,
+		bumpPercent:  20,
+		replaceAfter: 180 * time.Second,
+	}
+	if 0 < c.GetGas().GetMaxGasPriceGwei() {
+		p.maxPrice = new(big.Int).Mul(new(big.Int).SetUint64(c.GetGas().GetMaxGasPriceGwei()), gwei)
+	}
+	if 0 < c.GetGas().GetMaxGasLimit() {
+		p.maxLimit = c.GetGas().GetMaxGasLimit()
+	}
+	// 节点要求替换交易至少上调 10%
+	if 10 <= c.GetGas().GetBumpPercent() {
+		p.bumpPercent = c.GetGas().GetBumpPercent()
+	}
+	if nil != c.GetGas().GetReplaceAfter() && 0 < c.GetGas().GetReplaceAfter().AsDuration() {
+		p.replaceAfter = c.GetGas().GetReplaceAfter().AsDuration()
+	}
+
+	return p
+}
+
+// Price 节点建议价超过上限时不发交易，等价格回落
+func (p *Policy) Price(suggested *big.Int) (*big.Int, error) {
+	if suggested.Cmp(p.maxPrice) > 0 {
+		return nil, ErrPriceCap
+	}
+
+	return new(big.Int).Set(suggested), nil
+}
+
+// CheckLimit 估算的 gas limit 不能超过上限，批量打款按笔数放大
+func (p *Policy) CheckLimit(gasLimit uint64, count int) error {
+	if 1 > count {
+		count = 1
+	}
+	if gasLimit > p.maxLimit*uint64(count) {
+		return ErrLimitCap
+	}
+
+	return nil
+}
+
+// Bump 替换交易的 gas price，原价上调 bumpPercent 且不低于当前建议价，超过上限时报错
+func (p *Policy) Bump(old *big.Int, suggested *big.Int) (*big.Int, error) {
+	price := new(big.Int).Mul(old, new(big.Int).SetUint64(100+p.bumpPercent))
+	price.Div(price, big.NewInt(100))
+	if nil != suggested && suggested.Cmp(price) > 0 {
+		price.Set(suggested)
+	}
+	if price.Cmp(p.maxPrice) > 0 {
+		return nil, ErrPriceCap
+	}
+
+	return price, nil
+}
+
+// ReplaceAfter 交易多久未上链需要加速
+func (p *Policy) ReplaceAfter() time.Duration {
+	return p.replaceAfter
+}
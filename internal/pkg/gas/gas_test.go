@@ -0,0 +1,115 @@
+package gas
+
+import (
+	"cardbinance/internal/conf"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func gweiOf(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), gwei)
+}
+
+func TestNewPolicyDefaults(t *testing.T) {
+	p := NewPolicy(&conf.Chain{})
+	if 0 != p.maxPrice.Cmp(gweiOf(10)) || 200000 != p.maxLimit || 20 != p.bumpPercent || 180*time.Second != p.ReplaceAfter() {
+		t.Fatalf("defaults = %s %d %d %s", p.maxPrice, p.maxLimit, p.bumpPercent, p.ReplaceAfter())
+	}
+
+	// 低于 10% 的上调比例节点不接受替换，按默认值
+	p = NewPolicy(&conf.Chain{Gas: &conf.Chain_Gas{
+		MaxGasPriceGwei: 5,
+		MaxGasLimit:     100000,
+		BumpPercent:     5,
+		ReplaceAfter:    durationpb.New(time.Minute),
+	}})
+	if 0 != p.maxPrice.Cmp(gweiOf(5)) || 100000 != p.maxLimit || 20 != p.bumpPercent || time.Minute != p.ReplaceAfter() {
+		t.Fatalf("configured = %s %d %d %s", p.maxPrice, p.maxLimit, p.bumpPercent, p.ReplaceAfter())
+	}
+}
+
+func TestPrice(t *testing.T) {
+	p := NewPolicy(&conf.Chain{Gas: &conf.Chain_Gas{MaxGasPriceGwei: 5}})
+
+	tests := []struct {
+		name      string
+		suggested *big.Int
+		want      *big.Int
+		wantErr   error
+	}{
+		{name: "below cap", suggested: gweiOf(3), want: gweiOf(3)},
+		{name: "at cap", suggested: gweiOf(5), want: gweiOf(5)},
+		{name: "above cap", suggested: new(big.Int).Add(gweiOf(5), big.NewInt(1)), wantErr: ErrPriceCap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Price(tt.suggested)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if nil == tt.wantErr && 0 != got.Cmp(tt.want) {
+				t.Fatalf("price = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckLimit(t *testing.T) {
+	p := NewPolicy(&conf.Chain{Gas: &conf.Chain_Gas{MaxGasLimit: 100000}})
+
+	tests := []struct {
+		name     string
+		gasLimit uint64
+		count    int
+		wantErr  error
+	}{
+		{name: "single", gasLimit: 100000, count: 1},
+		{name: "single above cap", gasLimit: 100001, count: 1, wantErr: ErrLimitCap},
+		{name: "zero count as single", gasLimit: 100001, count: 0, wantErr: ErrLimitCap},
+		{name: "batch scales cap", gasLimit: 300000, count: 3},
+		{name: "batch above cap", gasLimit: 300001, count: 3, wantErr: ErrLimitCap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.CheckLimit(tt.gasLimit, tt.count); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBump(t *testing.T) {
+	p := NewPolicy(&conf.Chain{Gas: &conf.Chain_Gas{MaxGasPriceGwei: 5, BumpPercent: 20}})
+
+	tests := []struct {
+		name      string
+		old       *big.Int
+		suggested *big.Int
+		want      *big.Int
+		wantErr   error
+	}{
+		{name: "bump old price", old: gweiOf(1), suggested: gweiOf(1), want: big.NewInt(1200000000)},
+		{name: "suggested higher than bump", old: gweiOf(1), suggested: gweiOf(3), want: gweiOf(3)},
+		{name: "no suggested price", old: gweiOf(2), want: big.NewInt(2400000000)},
+		{name: "bump above cap", old: gweiOf(5), suggested: gweiOf(1), wantErr: ErrPriceCap},
+		{name: "suggested above cap", old: gweiOf(1), suggested: gweiOf(6), wantErr: ErrPriceCap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Bump(tt.old, tt.suggested)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if nil == tt.wantErr && 0 != got.Cmp(tt.want) {
+				t.Fatalf("price = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
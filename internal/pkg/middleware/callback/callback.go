@@ -0,0 +1,168 @@
+package callback
+
+import (
+	"bytes"
+	"cardbinance/internal/conf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	HeaderTimestamp = "X-Timestamp"
+	HeaderSign      = "X-Sign"
+)
+
+var (
+	ErrIpNotAllowed = errors.New("callback ip not allowed")
+	ErrTimestamp    = errors.New("callback timestamp expired")
+	ErrSign         = errors.New("callback sign error")
+	ErrReplay       = errors.New("callback replayed")
+)
+
+// Sign 回调签名 hex(hmac_sha256(secret, timestamp + body))
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verifier 发卡方回调验签：来源ip白名单、时间窗口、签名、防重放
+// 防重放只记在本进程内存里，重启或多实例部署时窗口内的重放仍会通过，
+// 真正的去重依赖回调收件箱 card_callback_event.event_id 的唯一索引（见 CallBackEventSave），不能去掉
+type Verifier struct {
+	secret       string
+	window       time.Duration
+	allowIps     map[string]struct{}
+	realIpHeader string
+	log          *log.Helper
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func NewVerifier(c *conf.CardIssuer, logger log.Logger) *Verifier {
+	v := &Verifier{
+		secret:   c.GetSignKey(),
+		window:   5 * time.Minute,
+		allowIps: make(map[string]struct{}, 0),
+		log:      log.NewHelper(logger),
+		seen:     make(map[string]time.Time, 0),
+	}
+
+	cb := c.GetCallback()
+	if "" != cb.GetSecret() {
+		v.secret = cb.GetSecret()
+	}
+	if nil != cb.GetWindow() && 0 < cb.GetWindow().AsDuration() {
+		v.window = cb.GetWindow().AsDuration()
+	}
+	for _, ip := range cb.GetAllowIps() {
+		v.allowIps[ip] = struct{}{}
+	}
+	v.realIpHeader = cb.GetRealIpHeader()
+
+	return v
+}
+
+func (v *Verifier) clientIp(r *http.Request) string {
+	if "" != v.realIpHeader {
+		if ip := strings.TrimSpace(strings.Split(r.Header.Get(v.realIpHeader), ",")[0]); "" != ip {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Verify 校验一次回调请求，body 为原始请求体
+func (v *Verifier) Verify(r *http.Request, body []byte) error {
+	if 0 < len(v.allowIps) {
+		if _, ok := v.allowIps[v.clientIp(r)]; !ok {
+			return ErrIpNotAllowed
+		}
+	}
+
+	timestamp := r.Header.Get(HeaderTimestamp)
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrTimestamp
+	}
+	now := time.Now()
+	sendTime := time.Unix(ts, 0)
+	if sendTime.Before(now.Add(-v.window)) || sendTime.After(now.Add(v.window)) {
+		return ErrTimestamp
+	}
+
+	sign := r.Header.Get(HeaderSign)
+	if !hmac.Equal([]byte(sign), []byte(Sign(v.secret, timestamp, body))) {
+		return ErrSign
+	}
+
+	// 窗口内同一签名只接受一次
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for k, t := range v.seen {
+		if t.Before(now.Add(-2 * v.window)) {
+			delete(v.seen, k)
+		}
+	}
+	if _, ok := v.seen[sign]; ok {
+		return ErrReplay
+	}
+	v.seen[sign] = now
+
+	return nil
+}
+
+// Handler 包装回调处理函数，验签失败返回 401
+func (v *Verifier) Handler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read body error", http.StatusBadRequest)
+			return
+		}
+
+		if err = v.Verify(r, body); err != nil {
+			eventType, eventId := eventOf(body)
+			v.log.Warnf("callback rejected: %v, ip=%s, timestamp=%s, eventType=%s, eventId=%s, body_sha256=%s", err, v.clientIp(r), r.Header.Get(HeaderTimestamp), eventType, eventId, bodyDigest(body))
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next(w, r)
+	}
+}
+
+// eventOf 只取事件类型和id用于日志，报文里有卡号和持卡人信息不能落日志
+func eventOf(body []byte) (string, string) {
+	var event struct {
+		EventType string `json:"eventType"`
+		EventId   string `json:"eventId"`
+	}
+	_ = json.Unmarshal(body, &event)
+	return event.EventType, event.EventId
+}
+
+// bodyDigest 报文 sha256 前 16 位，排查时和发卡方核对
+func bodyDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,268 @@
+package rpcpool
+
+import (
+	"cardbinance/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/log"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNoNode     = errors.New("no rpc node available")
+	ErrWrongChain = errors.New("rpc node chain id mismatch")
+)
+
+// Backend 节点客户端，线上是 ethclient，测试可以换成模拟链
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	Close()
+}
+
+// Dialer 按 url 建立节点连接
+type Dialer func(ctx context.Context, url string) (Backend, error)
+
+func dialEth(ctx context.Context, url string) (Backend, error) {
+	client, err := ethclient.DialContext(ctx, url)
+	if nil != err {
+		return nil, err
+	}
+	return client, nil
+}
+
+// node 单个节点，记录延迟和连续失败次数
+type node struct {
+	url string
+
+	mu         sync.Mutex
+	client     Backend
+	latency    time.Duration // 平滑后的延迟
+	failures   int           // 连续失败次数
+	downUntil  time.Time     // 暂停使用到什么时候
+	wrongChain bool          // 链id不一致，不再使用
+}
+
+// Pool rpc 节点池，优先选连续失败少、延迟低的节点，节点首次连接时校验链id
+type Pool struct {
+	chainId     uint64
+	nodes       []*node
+	maxFailures int
+	cooldown    time.Duration
+	timeout     time.Duration
+	dialer      Dialer
+	log         *log.Helper
+}
+
+func NewPool(c *conf.Chain, logger log.Logger) (*Pool, func(), error) {
+	return NewPoolWithDialer(c, dialEth, logger)
+}
+
+// NewPoolWithDialer 自定义节点连接方式，测试里用模拟链代替 rpc 节点
+func NewPoolWithDialer(c *conf.Chain, dialer Dialer, logger log.Logger) (*Pool, func(), error) {
+	p := &Pool{
+		chainId:     c.GetChainId(),
+		nodes:       make([]*node, 0),
+		maxFailures: 3,
+		cooldown:    30 * time.Second,
+		timeout:     10 * time.Second,
+		dialer:      dialer,
+		log:         log.NewHelper(logger),
+	}
+	if 0 == p.chainId {
+		p.chainId = 56
+	}
+	if 0 < c.GetPool().GetMaxFailures() {
+		p.maxFailures = int(c.GetPool().GetMaxFailures())
+	}
+	if nil != c.GetPool().GetCooldown() && 0 < c.GetPool().GetCooldown().AsDuration() {
+		p.cooldown = c.GetPool().GetCooldown().AsDuration()
+	}
+	if nil != c.GetPool().GetTimeout() && 0 < c.GetPool().GetTimeout().AsDuration() {
+		p.timeout = c.GetPool().GetTimeout().AsDuration()
+	}
+
+	for _, network := range c.GetNetworks() {
+		if p.chainId != network.GetChainId() {
+			continue
+		}
+		for _, rpcUrl := range network.GetRpcUrls() {
+			p.nodes = append(p.nodes, &node{url: rpcUrl})
+		}
+	}
+	if 0 >= len(p.nodes) {
+		return nil, nil, fmt.Errorf("chain %d has no rpc url", p.chainId)
+	}
+
+	cleanup := func() {
+		for _, n := range p.nodes {
+			n.mu.Lock()
+			if nil != n.client {
+				n.client.Close()
+				n.client = nil
+			}
+			n.mu.Unlock()
+		}
+	}
+
+	return p, cleanup, nil
+}
+
+// ChainId 当前使用的链id
+func (p *Pool) ChainId() uint64 {
+	return p.chainId
+}
+
+// sorted 可用节点排序，暂停中的排在最后兜底
+func (p *Pool) sorted() []*node {
+	type score struct {
+		n        *node
+		down     bool
+		failures int
+		latency  time.Duration
+	}
+
+	now := time.Now()
+	scores := make([]*score, 0, len(p.nodes))
+	for _, n := range p.nodes {
+		n.mu.Lock()
+		if !n.wrongChain {
+			scores = append(scores, &score{
+				n:        n,
+				down:     now.Before(n.downUntil),
+				failures: n.failures,
+				latency:  n.latency,
+			})
+		}
+		n.mu.Unlock()
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].down != scores[j].down {
+			return !scores[i].down
+		}
+		if scores[i].failures != scores[j].failures {
+			return scores[i].failures < scores[j].failures
+		}
+		return scores[i].latency < scores[j].latency
+	})
+
+	res := make([]*node, 0, len(scores))
+	for _, v := range scores {
+		res = append(res, v.n)
+	}
+	return res
+}
+
+// dial 复用连接，新连接先校验链id
+func (p *Pool) dial(ctx context.Context, n *node) (Backend, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if nil != n.client {
+		return n.client, nil
+	}
+
+	client, err := p.dialer(ctx, n.url)
+	if nil != err {
+		return nil, err
+	}
+
+	chainId, err := client.ChainID(ctx)
+	if nil != err {
+		client.Close()
+		return nil, err
+	}
+	if p.chainId != chainId.Uint64() {
+		client.Close()
+		n.wrongChain = true
+		p.log.Errorf("rpc node %s chain id %d, expected %d, disabled", n.url, chainId.Uint64(), p.chainId)
+		return nil, ErrWrongChain
+	}
+
+	n.client = client
+	return client, nil
+}
+
+func (p *Pool) done(n *node, latency time.Duration, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if nil == err {
+		n.failures = 0
+		if 0 == n.latency {
+			n.latency = latency
+		} else {
+			n.latency = (n.latency*7 + latency*3) / 10
+		}
+		return
+	}
+
+	n.failures++
+	if n.failures >= p.maxFailures {
+		n.downUntil = time.Now().Add(p.cooldown)
+	}
+
+	// 出错后重连，避免一直用坏掉的连接
+	if nil != n.client {
+		n.client.Close()
+		n.client = nil
+	}
+}
+
+// call 超时同时作用于建连和 fn，fn 内的 rpc 调用要用传入的 ctx
+func (p *Pool) call(ctx context.Context, n *node, fn func(ctx context.Context, client Backend) error) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	client, err := p.dial(ctx, n)
+	if nil == err {
+		err = fn(ctx, client)
+	}
+	if !errors.Is(err, ErrWrongChain) {
+		p.done(n, time.Since(start), err)
+	}
+	if nil != err {
+		p.log.Warnf("rpc node %s error: %v", n.url, err)
+	}
+
+	return err
+}
+
+// Do 读类调用，按排序依次尝试，成功一个即返回
+func (p *Pool) Do(ctx context.Context, fn func(ctx context.Context, client Backend) error) error {
+	err := ErrNoNode
+	for _, n := range p.sorted() {
+		if err = p.call(ctx, n, fn); nil == err {
+			return nil
+		}
+		if nil != ctx.Err() {
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
+
+// Once 发送交易等不能自动换节点重试的调用，只用当前最优节点
+func (p *Pool) Once(ctx context.Context, fn func(ctx context.Context, client Backend) error) error {
+	nodes := p.sorted()
+	if 0 >= len(nodes) {
+		return ErrNoNode
+	}
+
+	return p.call(ctx, nodes[0], fn)
+}
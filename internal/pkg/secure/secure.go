@@ -0,0 +1,181 @@
+package secure
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// 密文前缀，没有前缀的是加密之前写入的明文
+const prefix = "enc:"
+
+var (
+	ErrKey = errors.New("encrypt key must be 32 bytes hex")
+)
+
+// Cipher 敏感字段加密，AES-256-GCM，密文为 enc: + base64(nonce + ciphertext)
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher key 为 64 位 hex，为空时不加密
+func NewCipher(key string) (*Cipher, error) {
+	if "" == key {
+		return &Cipher{}, nil
+	}
+
+	k, err := hex.DecodeString(key)
+	if nil != err || 32 != len(k) {
+		return nil, ErrKey
+	}
+
+	block, err := aes.NewCipher(k)
+	if nil != err {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if nil != err {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Enabled 是否配置了密钥
+func (c *Cipher) Enabled() bool {
+	return nil != c.aead
+}
+
+// Encrypted 是否已经是密文
+func Encrypted(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// Encrypt 空值、默认值 no 和已加密的值原样返回
+func (c *Cipher) Encrypt(s string) (string, error) {
+	if nil == c.aead || "" == s || "no" == s || Encrypted(s) {
+		return s, nil
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); nil != err {
+		return "", err
+	}
+
+	return prefix + base64.StdEncoding.EncodeToString(c.aead.Seal(nonce, nonce, []byte(s), nil)), nil
+}
+
+// Decrypt 明文原样返回
+func (c *Cipher) Decrypt(s string) (string, error) {
+	if !Encrypted(s) {
+		return s, nil
+	}
+	if nil == c.aead {
+		return "", ErrKey
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, prefix))
+	if nil != err {
+		return "", err
+	}
+	if len(data) < c.aead.NonceSize() {
+		return "", errors.New("ciphertext too short")
+	}
+
+	plain, err := c.aead.Open(nil, data[:c.aead.NonceSize()], data[c.aead.NonceSize():], nil)
+	if nil != err {
+		return "", err
+	}
+
+	return string(plain), nil
+}
+
+// MaskPan 卡号只保留后4位
+func MaskPan(pan string) string {
+	if "" == pan || "no" == pan {
+		return pan
+	}
+	if 4 >= len(pan) {
+		return "****"
+	}
+
+	return "**** **** **** " + pan[len(pan)-4:]
+}
+
+// 报文中需要脱敏的字段，签名、密钥、卡号、持卡人信息
+var redactKeys = map[string]bool{
+	"sign":        true,
+	"signkey":     true,
+	"pan":         true,
+	"cardnumber":  true,
+	"cvv":         true,
+	"cvc":         true,
+	"expirydate":  true,
+	"email":       true,
+	"phonenumber": true,
+	"birthdate":   true,
+	"street":      true,
+	"firstname":   true,
+	"lastname":    true,
+	"postalcode":  true,
+}
+
+// RedactKey 字段是否需要脱敏，不区分大小写
+func RedactKey(key string) bool {
+	return redactKeys[strings.ToLower(key)]
+}
+
+// RedactJSON json 报文脱敏，卡号保留后4位，非 json 原样返回
+func RedactJSON(data []byte) []byte {
+	if 0 == len(data) {
+		return data
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); nil != err {
+		return data
+	}
+
+	res, err := json.Marshal(redactValue("", v))
+	if nil != err {
+		return data
+	}
+
+	return res
+}
+
+func redactValue(key string, v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = redactValue(k, item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(key, item)
+		}
+		return value
+	case string:
+		if !RedactKey(key) {
+			return value
+		}
+		lowerKey := strings.ToLower(key)
+		if ("pan" == lowerKey || "cardnumber" == lowerKey) && 4 < len(value) {
+			return "****" + value[len(value)-4:]
+		}
+		return "***"
+	default:
+		if RedactKey(key) && nil != v {
+			return "***"
+		}
+		return v
+	}
+}
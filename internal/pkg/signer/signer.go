@@ -0,0 +1,241 @@
+package signer
+
+import (
+	"bytes"
+	"cardbinance/internal/conf"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	TypeKeystore = "keystore"
+	TypeEnv      = "env"
+	TypeRemote   = "remote"
+)
+
+var (
+	ErrFrom = errors.New("signer address mismatch")
+)
+
+// Signer 提现热钱包签名
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// NewSigner 按配置选择签名方式，密钥只从文件和环境变量读取
+func NewSigner(c *conf.Chain) (Signer, error) {
+	sc := c.GetSigner()
+
+	switch sc.GetType() {
+	case TypeKeystore:
+		return newKeystoreSigner(sc)
+	case TypeEnv:
+		return newEnvSigner(sc)
+	case TypeRemote:
+		return newRemoteSigner(sc)
+	default:
+		return nil, fmt.Errorf("unknown signer type: %q", sc.GetType())
+	}
+}
+
+func envName(name string, def string) string {
+	if "" == name {
+		return def
+	}
+	return name
+}
+
+// localSigner 私钥在进程内存中
+type localSigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func newLocalSigner(key *ecdsa.PrivateKey) *localSigner {
+	return &localSigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s *localSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *localSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainId), s.key)
+}
+
+// newKeystoreSigner 加密的 json 密钥文件，口令来自环境变量
+func newKeystoreSigner(sc *conf.Signer) (Signer, error) {
+	keyJson, err := os.ReadFile(sc.GetKeystorePath())
+	if nil != err {
+		return nil, fmt.Errorf("read keystore error: %v", err)
+	}
+
+	passphraseEnv := envName(sc.GetPassphraseEnv(), "HOT_WALLET_PASSPHRASE")
+	passphrase, ok := os.LookupEnv(passphraseEnv)
+	if !ok {
+		return nil, fmt.Errorf("env %s not set", passphraseEnv)
+	}
+
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if nil != err {
+		return nil, fmt.Errorf("decrypt keystore error: %v", err)
+	}
+
+	return newLocalSigner(key.PrivateKey), nil
+}
+
+// newEnvSigner 环境变量里的私钥，仅开发使用
+func newEnvSigner(sc *conf.Signer) (Signer, error) {
+	keyEnv := envName(sc.GetPrivateKeyEnv(), "HOT_WALLET_PRIVATE_KEY")
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(os.Getenv(keyEnv), "0x"))
+	if nil != err {
+		return nil, fmt.Errorf("env %s private key error: %v", keyEnv, err)
+	}
+
+	return newLocalSigner(key), nil
+}
+
+// SignRequest 远程签名请求，tx 为未签名交易 MarshalBinary 的 hex
+type SignRequest struct {
+	ChainId string `json:"chainId"`
+	From    string `json:"from"`
+	Tx      string `json:"tx"`
+}
+
+type SignResponse struct {
+	Code     int    `json:"code"`
+	Msg      string `json:"msg"`
+	SignedTx string `json:"signedTx"`
+}
+
+// remoteSigner 私钥在远程签名服务，本进程只拿签好的交易
+type remoteSigner struct {
+	url     string
+	token   string
+	address common.Address
+	http    *http.Client
+}
+
+func newRemoteSigner(sc *conf.Signer) (Signer, error) {
+	if "" == sc.GetRemoteUrl() || !common.IsHexAddress(sc.GetRemoteAddress()) {
+		return nil, errors.New("remote signer url and address required")
+	}
+
+	timeout := 10 * time.Second
+	if nil != sc.GetRemoteTimeout() && 0 < sc.GetRemoteTimeout().AsDuration() {
+		timeout = sc.GetRemoteTimeout().AsDuration()
+	}
+
+	return &remoteSigner{
+		url:     strings.TrimRight(sc.GetRemoteUrl(), "/"),
+		token:   os.Getenv(envName(sc.GetRemoteTokenEnv(), "HOT_WALLET_SIGNER_TOKEN")),
+		address: common.HexToAddress(sc.GetRemoteAddress()),
+		http:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *remoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *remoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	raw, err := tx.MarshalBinary()
+	if nil != err {
+		return nil, err
+	}
+
+	jsonData, _ := json.Marshal(&SignRequest{
+		ChainId: chainId.String(),
+		From:    s.address.Hex(),
+		Tx:      hexutil.Encode(raw),
+	})
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url+"/sign", bytes.NewReader(jsonData))
+	if nil != err {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.token)
+
+	resp, err := s.http.Do(req)
+	if nil != err {
+		return nil, fmt.Errorf("remote signer error: %v", err)
+	}
+	defer func(Body io.ReadCloser) {
+		errTwo := Body.Close()
+		if errTwo != nil {
+
+		}
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if nil != err {
+		return nil, err
+	}
+	if http.StatusOK != resp.StatusCode {
+		return nil, fmt.Errorf("remote signer status: %d %s", resp.StatusCode, string(body))
+	}
+
+	var result SignResponse
+	if err = json.Unmarshal(body, &result); nil != err {
+		return nil, err
+	}
+	if 0 != result.Code {
+		return nil, fmt.Errorf("remote signer refused: %s", result.Msg)
+	}
+
+	signedRaw, err := hexutil.Decode(result.SignedTx)
+	if nil != err {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(signedRaw); nil != err {
+		return nil, err
+	}
+
+	// 签好的交易必须和请求一致，且由约定的地址签名
+	if !sameTx(tx, signed) {
+		return nil, errors.New("remote signer returned a different tx")
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	if nil != err {
+		return nil, err
+	}
+	if from != s.address {
+		return nil, ErrFrom
+	}
+
+	return signed, nil
+}
+
+// sameTx 除签名外的字段一致
+func sameTx(a *types.Transaction, b *types.Transaction) bool {
+	if (nil == a.To()) != (nil == b.To()) {
+		return false
+	}
+	if nil != a.To() && *a.To() != *b.To() {
+		return false
+	}
+
+	return a.Type() == b.Type() &&
+		a.Nonce() == b.Nonce() &&
+		a.Gas() == b.Gas() &&
+		0 == a.GasPrice().Cmp(b.GasPrice()) &&
+		0 == a.GasTipCap().Cmp(b.GasTipCap()) &&
+		0 == a.Value().Cmp(b.Value()) &&
+		bytes.Equal(a.Data(), b.Data())
+}
@@ -3,6 +3,7 @@ package server
 import (
 	v1 "cardbinance/api/user/v1"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/middleware/callback"
 	"cardbinance/internal/service"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,7 +16,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, ci *conf.CardIssuer, userService *service.UserService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -43,7 +44,8 @@ func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.
 	srv := http.NewServer(opts...)
 	v1.RegisterUserHTTPServer(srv, userService)
 
-	srv.HandleFunc("/api/admin_dhb/callback", userService.CallBack)
+	// 发卡方回调不走 jwt，单独验签
+	srv.HandleFunc("/api/admin_dhb/callback", callback.NewVerifier(ci, logger).Handler(userService.CallBack))
 	return srv
 }
 
@@ -56,6 +58,13 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList["/api.user.v1.User/Deposit"] = struct{}{}
 	whiteList["/api.user.v1.User/AdminWithdrawEth"] = struct{}{}
 	whiteList["/api.user.v1.User/RewardCardTwo"] = struct{}{}
+	whiteList["/api.user.v1.User/CallbackEventHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/CardProductSyncHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/CardRechargeHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/CardTransactionSyncHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/CardBalanceHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/SensitiveEncryptHandle"] = struct{}{}
+	whiteList["/api.user.v1.User/WithdrawConfirmHandle"] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
 			return false
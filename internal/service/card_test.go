@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"bytes"
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data/ispay"
+	"cardbinance/internal/pkg/fakeissuer"
+	"cardbinance/internal/pkg/fakerepo"
+	"cardbinance/internal/pkg/middleware/callback"
+	"cardbinance/internal/service"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var testLogger = log.NewStdLogger(io.Discard)
+
+// newCardRepo 用户 1、2 已通过持卡人审核，各有一张已扣费待开的主卡 1、2
+// 用户 3 是 1 的直推人 vip5，用户 4 是 3 的上级 vip10
+func newCardRepo(holderIds ...string) *fakerepo.Repo {
+	repo := fakerepo.New()
+	repo.AddProduct(&biz.CardProductInfo{ProductId: "1001", ProductStatus: "ENABLED", Enable: 1, MaxCardQuota: 10})
+
+	for i, holderId := range holderIds {
+		id := uint64(i + 1)
+		repo.AddUser(&biz.User{
+			ID:             id,
+			Address:        fmt.Sprintf("0xuser%d", id),
+			Card:           "no",
+			CardNumber:     "no",
+			CardOrderId:    "do",
+			CardUserId:     holderId,
+			CardUserStatus: biz.CardHolderActive,
+			ProductId:      "1001",
+		}, "D4D3")
+		repo.AddCard(&biz.UserCard{
+			ID:          id,
+			UserId:      id,
+			ProductId:   "1001",
+			Status:      biz.UserCardApplied,
+			Main:        1,
+			Fee:         10,
+			FeeRewardId: id,
+		})
+	}
+	repo.AddUser(&biz.User{ID: 3, Address: "0xuser3", Vip: 5, CardOrderId: "no"}, "D4")
+	repo.AddUser(&biz.User{ID: 4, Address: "0xuser4", Vip: 10, CardOrderId: "no"}, "")
+
+	return repo
+}
+
+// TestIssuerOpenCardFlow 对接模拟发卡方走完整流程：开卡 → 状态任务查到审核中 → 发卡方激活回调经验签落库 → 回调任务写卡号并分红 → 修改限额
+func TestIssuerOpenCardFlow(t *testing.T) {
+	const (
+		merchantId = "322338"
+		signKey    = "sign-key"
+	)
+	ctx := context.Background()
+
+	fake := fakeissuer.NewServer(merchantId, signKey)
+	issuer := httptest.NewServer(fake)
+	defer issuer.Close()
+
+	// 用户 1 的持卡人在发卡方已审核通过，用户 2 的持卡人不存在，开卡失败退款
+	holderId := fake.AddHolder(fakeissuer.HolderStatusActive)
+	repo := newCardRepo(holderId, "99999")
+
+	ci := &conf.CardIssuer{BaseUrl: issuer.URL, MerchantId: merchantId, SignKey: signKey}
+	uuc := biz.NewUserUseCase(repo, fakerepo.Tx{}, ispay.NewCardProvider(ci, repo, testLogger), nil, testLogger)
+
+	svc := service.NewUserService(uuc, testLogger, nil, nil, nil, nil, nil)
+	callbackServer := httptest.NewServer(callback.NewVerifier(ci, testLogger).Handler(svc.CallBack))
+	defer callbackServer.Close()
+	fake.CallbackUrl = callbackServer.URL
+
+	if err := uuc.OpenCardHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	card := repo.SavedCard(1)
+	if biz.UserCardOpening != card.Status || "" == card.CardId {
+		t.Fatalf("card 1 = %s %s, want opening", card.Status, card.CardId)
+	}
+	if c := repo.SavedCard(2); biz.UserCardFailed != c.Status || 10 != repo.SavedUser(2).Amount {
+		t.Fatalf("card 2 = %s, user amount %f, want failed and refunded", c.Status, repo.SavedUser(2).Amount)
+	}
+	if issued, ok := fake.Card(card.CardId); !ok || holderId != issued.HolderId || fakeissuer.CardStatusPending != issued.CardStatus {
+		t.Fatalf("issuer card = %+v, want pending for holder %s", issued, holderId)
+	}
+	if 0 >= len(repo.SavedJournals()) {
+		t.Fatal("issuer requests not journaled")
+	}
+
+	if err := uuc.CardStatusHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if c := repo.SavedCard(1); biz.UserCardOpening != c.Status || 0 < len(repo.SavedRewards()) {
+		t.Fatalf("card 1 = %s, rewards %+v, want opening without rewards", c.Status, repo.SavedRewards())
+	}
+
+	// 签名不对的回调不落库
+	body := []byte(`{"eventType":"vcc.card.activated","eventId":"forged","data":{"cardId":"` + card.CardId + `"}}`)
+	req, err := http.NewRequest("POST", callbackServer.URL, bytes.NewReader(body))
+	if nil != err {
+		t.Fatal(err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(callback.HeaderTimestamp, timestamp)
+	req.Header.Set(callback.HeaderSign, callback.Sign("wrong-key", timestamp, body))
+	resp, err := http.DefaultClient.Do(req)
+	if nil != err {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if http.StatusUnauthorized != resp.StatusCode || 0 < len(repo.SavedEvents()) {
+		t.Fatalf("forged callback status %d, events %d", resp.StatusCode, len(repo.SavedEvents()))
+	}
+
+	if !fake.SetCardStatus(card.CardId, fakeissuer.CardStatusActive) {
+		t.Fatalf("issuer card %s not found", card.CardId)
+	}
+	issued, _ := fake.Card(card.CardId)
+	if err = fake.SendCallback(ctx, "vcc.card.activated", map[string]string{"merchantId": merchantId, "cardId": card.CardId, "cardNumber": issued.Pan}); nil != err {
+		t.Fatal(err)
+	}
+	events := repo.SavedEvents()
+	if 1 != len(events) || "pending" != events[0].Status || "vcc.card.activated" != events[0].EventType {
+		t.Fatalf("events = %+v, want one pending", events)
+	}
+
+	// 后台回调列表里的卡号脱敏
+	list, err := uuc.AdminCallbackEventList(ctx, &pb.AdminCallbackEventListRequest{Page: 1})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != len(list.Events) || strings.Contains(list.Events[0].Payload, issued.Pan) || !strings.Contains(list.Events[0].Payload, "****"+issued.Pan[len(issued.Pan)-4:]) {
+		t.Fatalf("admin payload = %+v, want masked card number", list.Events)
+	}
+
+	if err = uuc.CallbackEventHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if events = repo.SavedEvents(); "success" != events[0].Status {
+		t.Fatalf("event = %s %s, want success", events[0].Status, events[0].Remark)
+	}
+
+	issued, _ = fake.Card(card.CardId)
+	card = repo.SavedCard(1)
+	if biz.UserCardActive != card.Status || issued.Pan != card.CardNumber || issued.Pan != repo.SavedUser(1).CardNumber {
+		t.Fatalf("card 1 = %s %s, user card number %s, want active %s", card.Status, card.CardNumber, repo.SavedUser(1).CardNumber, issued.Pan)
+	}
+	wantRewards := []fakerepo.Reward{
+		{UserId: 3, Amount: 5, Vip: 5, Address: "0xuser1"},
+		{UserId: 4, Amount: 5, Vip: 10, Address: "0xuser1"},
+	}
+	if rewards := repo.SavedRewards(); len(wantRewards) != len(rewards) || wantRewards[0] != rewards[0] || wantRewards[1] != rewards[1] {
+		t.Fatalf("rewards = %+v, want %+v", rewards, wantRewards)
+	}
+
+	// 回调和状态任务都处理过后不会重复分红
+	if err = uuc.CardStatusHandle(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if rewards := repo.SavedRewards(); len(wantRewards) != len(rewards) {
+		t.Fatalf("rewards after status job = %+v", rewards)
+	}
+
+	if err = uuc.CardLimit(ctx, 1, card.CardId, 500, 3000); nil != err {
+		t.Fatal(err)
+	}
+	if issued, _ = fake.Card(card.CardId); "500" != issued.DailyLimit || "3000" != issued.MonthlyLimit {
+		t.Fatalf("issuer limits = %s/%s, want 500/3000", issued.DailyLimit, issued.MonthlyLimit)
+	}
+}
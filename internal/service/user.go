@@ -4,15 +4,23 @@ import (
 	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
 	"context"
-	"crypto/ecdsa"
+	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+	"io"
 	"math/big"
 	"net/http"
 	"strconv"
@@ -23,13 +31,17 @@ import (
 type UserService struct {
 	pb.UnimplementedUserServer
 
-	uuc *biz.UserUseCase
-	log *log.Helper
-	ca  *conf.Auth
+	uuc    *biz.UserUseCase
+	log    *log.Helper
+	ca     *conf.Auth
+	pool   *rpcpool.Pool
+	signer signer.Signer // 提现热钱包
+	gas    *gas.Policy
+	cc     *conf.Chain
 }
 
-func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth) *UserService {
-	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca}
+func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth, pool *rpcpool.Pool, signer signer.Signer, gas *gas.Policy, cc *conf.Chain) *UserService {
+	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca, pool: pool, signer: signer, gas: gas, cc: cc}
 }
 
 func (u *UserService) OpenCardHandle(ctx context.Context, req *pb.OpenCardHandleRequest) (*pb.OpenCardHandleReply, error) {
@@ -76,7 +88,7 @@ func (u *UserService) CardStatusHandle(ctx context.Context, req *pb.CardStatusHa
 	return nil, nil
 }
 
-func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRequest) (*pb.RewardCardTwoReply, error) {
+func (u *UserService) CallbackEventHandle(ctx context.Context, req *pb.CallbackEventHandleRequest) (*pb.CallbackEventHandleReply, error) {
 	end := time.Now().UTC().Add(50 * time.Second)
 
 	var (
@@ -88,7 +100,7 @@ func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRe
 			break
 		}
 
-		err = u.uuc.CardTwoStatusHandle(ctx)
+		err = u.uuc.CallbackEventHandle(ctx)
 		if nil != err {
 			fmt.Println(err)
 		}
@@ -98,102 +110,63 @@ func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRe
 	return nil, nil
 }
 
-func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositReply, error) {
+func (u *UserService) CardProductSyncHandle(ctx context.Context, req *pb.CardProductSyncHandleRequest) (*pb.CardProductSyncHandleReply, error) {
+	err := u.uuc.CardProductSyncHandle(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	return nil, nil
+}
+
+func (u *UserService) CardRechargeHandle(ctx context.Context, req *pb.CardRechargeHandleRequest) (*pb.CardRechargeHandleReply, error) {
 	end := time.Now().UTC().Add(50 * time.Second)
 
+	var (
+		err error
+	)
 	for i := 1; i <= 10; i++ {
-		var (
-			depositUsdtResult []*userDeposit
-			depositUsers      map[string]*biz.User
-			fromAccount       []string
-			userLength        int64
-			last              int64
-			err               error
-		)
-
-		last, err = u.uuc.GetEthUserRecordLast()
-		if nil != err {
-			fmt.Println(err)
-			continue
-		}
-
-		if -1 == last {
-			fmt.Println(err)
-			continue
+		now := time.Now().UTC()
+		if end.Before(now) {
+			break
 		}
 
-		// 0x0299e92df88c034F6425e78b6f6A367e84160B45 test
-		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		userLength, err = getUserLength("0x0876D2b69D53Bf6e5710Aa6b46ea3a739596F864")
+		err = u.uuc.CardRechargeHandle(ctx)
 		if nil != err {
 			fmt.Println(err)
 		}
+		time.Sleep(5 * time.Second)
+	}
 
-		if -1 == userLength {
-			continue
-		}
-
-		if 0 == userLength {
-			break
-		}
-
-		if last >= userLength {
-			break
-		}
+	return nil, nil
+}
 
-		// 0x0299e92df88c034F6425e78b6f6A367e84160B454 test
-		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		depositUsdtResult, err = getUserInfo(last, userLength-1, "0x0876D2b69D53Bf6e5710Aa6b46ea3a739596F864")
-		if nil != err {
-			break
-		}
+func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRequest) (*pb.RewardCardTwoReply, error) {
+	end := time.Now().UTC().Add(50 * time.Second)
 
+	var (
+		err error
+	)
+	for i := 1; i <= 10; i++ {
 		now := time.Now().UTC()
-		//fmt.Println(now, end)
 		if end.Before(now) {
 			break
 		}
 
-		if 0 >= len(depositUsdtResult) {
-			break
-		}
-
-		for _, vUser := range depositUsdtResult {
-			fromAccount = append(fromAccount, vUser.Address)
+		err = u.uuc.CardTwoStatusHandle(ctx)
+		if nil != err {
+			fmt.Println(err)
 		}
+		time.Sleep(5 * time.Second)
+	}
 
-		depositUsers, err = u.uuc.GetUserByAddress(fromAccount...)
-		if nil != depositUsers {
-			// 统计开始
-			for _, vUser := range depositUsdtResult { // 主查usdt
-				if _, ok := depositUsers[vUser.Address]; !ok { // 用户不存在
-					continue
-				}
-
-				var (
-					tmpValue int64
-				)
-
-				if 10 <= vUser.Amount {
-					tmpValue = vUser.Amount
-				} else {
-					return &pb.DepositReply{}, nil
-				}
-
-				// 充值
-				err = u.uuc.DepositNew(ctx, depositUsers[vUser.Address].ID, uint64(tmpValue), &biz.EthUserRecord{ // 两种币的记录
-					UserId:    int64(depositUsers[vUser.Address].ID),
-					Amount:    strconv.FormatInt(tmpValue, 10) + "00000000000000000000",
-					AmountTwo: uint64(vUser.Amount),
-					Last:      userLength,
-				}, false)
-				if nil != err {
-					fmt.Println(err)
-				}
-			}
-		}
+	return nil, nil
+}
 
-		time.Sleep(5 * time.Second)
+func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositReply, error) {
+	err := u.uuc.DepositIndexHandle(ctx, time.Now().UTC().Add(50*time.Second))
+	if nil != err {
+		fmt.Println(err)
 	}
 
 	return nil, nil
@@ -235,6 +208,28 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 	)
 	end := time.Now().UTC().Add(50 * time.Second)
 
+	// 新提现先过风控，只打审核通过的
+	err = u.uuc.WithdrawReview(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	// 上次任务领取后中断、没落交易的放回待打款
+	err = u.uuc.WithdrawDoingRequeue(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	// 配置了 disperse 合约时批量打款
+	if "" != u.cc.GetDisperseContract() {
+		err = u.withdrawBatch(ctx)
+		if nil != err {
+			fmt.Println(err)
+		}
+
+		return &pb.AdminWithdrawEthReply{}, nil
+	}
+
 	for j := 1; j <= 10; j++ {
 		now := time.Now().UTC()
 		//fmt.Println(now, end)
@@ -262,13 +257,14 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 			continue
 		}
 
-		tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		_, err = u.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
+		tokenAddress = u.cc.GetUsdt()
+		// 条件更新领取，没改到说明已被其他任务领走
+		var claimed *biz.Withdraw
+		claimed, err = u.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
 		if nil != err {
 			continue
 		}
 
-		tmpUrl1 := "https://bsc-dataseed4.binance.org/"
 		withDrawAmount := FloatTo18DecimalsString(withdraw.RelAmount)
 		if len(withDrawAmount) <= 15 {
 			fmt.Println(withDrawAmount, withdraw)
@@ -276,29 +272,40 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 			continue
 		}
 
+		// 先签名不广播，nonce 和交易同一个事务落库，签名失败没有交易发出也不占 nonce，可以换节点重试
+		// gas 在事务外从节点取好，事务内只本地签名
+		var wt *biz.WithdrawTx
 		for i := 0; i <= 5; i++ {
-			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, err = toToken("", users[withdraw.UserId].Address, withDrawAmount, tokenAddress, tmpUrl1)
-			if err == nil {
-				_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
-				//time.Sleep(3 * time.Second)
+			var call *withdrawCall
+			err = u.pool.Once(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+				var errTwo error
+				call, errTwo = toToken(ctx, client, u.signer, u.gas, users[withdraw.UserId].Address, withDrawAmount, tokenAddress)
+				return errTwo
+			})
+			if nil == err {
+				wt, err = u.uuc.WithdrawSign(ctx, claimed, func(nonce uint64) (*biz.WithdrawTx, error) {
+					return call.sign(ctx, u.pool.ChainId(), u.signer, nonce)
+				})
+			}
+			if nil == err {
 				break
-			} else {
+			}
+
+			u.log.Errorf("withdraw sign failed: %v, withdrawId=%d, address=%s, amount=%s, token=%s", err, withdraw.ID, users[withdraw.UserId].Address, withDrawAmount, tokenAddress)
+			time.Sleep(3 * time.Second)
+		}
+		if nil != err {
+			err = u.uuc.UpdateWithdrawRequeue(ctx, withdraw.ID)
+			if nil != err {
 				fmt.Println(err)
-				if 0 == i {
-					tmpUrl1 = "https://bsc-dataseed1.binance.org"
-				} else if 1 == i {
-					tmpUrl1 = "https://bsc-dataseed3.binance.org"
-				} else if 2 == i {
-					tmpUrl1 = "https://bsc-dataseed2.binance.org"
-				} else if 3 == i {
-					tmpUrl1 = "https://bnb-bscnews.rpc.blxrbdn.com/"
-				} else if 4 == i {
-					tmpUrl1 = "https://bsc-dataseed.binance.org"
-				}
-				fmt.Println(33331, err, users[withdraw.UserId].Address, withdraw.Address, withDrawAmount, tokenAddress)
-				time.Sleep(3 * time.Second)
 			}
+			continue
+		}
+
+		// 交易已落库，广播结果交给确认任务，不再重试
+		err = u.uuc.SendWithdrawTx(ctx, wt)
+		if nil != err {
+			u.log.Warnf("withdraw send failed, left to confirm task: %v, withdrawId=%d, txHash=%s", err, withdraw.ID, wt.TxHash)
 		}
 
 		time.Sleep(5 * time.Second)
@@ -307,6 +314,99 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 	return &pb.AdminWithdrawEthReply{}, nil
 }
 
+// withdrawBatch 待打款的提现合并成一笔交易，批次、nonce 和交易同一个事务落库后广播
+func (u *UserService) withdrawBatch(ctx context.Context) error {
+	var (
+		withdraws    []*biz.Withdraw
+		batch        []*biz.Withdraw
+		userIds      []uint64
+		userIdsMap   map[uint64]uint64
+		users        map[uint64]*biz.User
+		recipients   []common.Address
+		values       []*big.Int
+		tokenAddress = u.cc.GetUsdt()
+		batchSize    = 50
+		wt           *biz.WithdrawTx
+		err          error
+	)
+
+	if 0 < u.cc.GetBatchSize() {
+		batchSize = int(u.cc.GetBatchSize())
+	}
+
+	withdraws, err = u.uuc.GetWithdrawsApproved(batchSize)
+	if nil != err {
+		return err
+	}
+	if 0 >= len(withdraws) {
+		return nil
+	}
+
+	userIdsMap = make(map[uint64]uint64, 0)
+	for _, v := range withdraws {
+		userIdsMap[v.UserId] = v.UserId
+	}
+	for _, v := range userIdsMap {
+		userIds = append(userIds, v)
+	}
+
+	users, err = u.uuc.GetUserByUserIds(userIds...)
+	if nil != err {
+		return err
+	}
+
+	for _, withdraw := range withdraws {
+		if _, ok := users[withdraw.UserId]; !ok {
+			continue
+		}
+
+		withDrawAmount := FloatTo18DecimalsString(withdraw.RelAmount)
+		if len(withDrawAmount) <= 15 {
+			fmt.Println(withDrawAmount, withdraw)
+			_, err = u.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
+			if nil != err {
+				continue
+			}
+			_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
+			continue
+		}
+
+		tmpWithdrawAmount, _ := new(big.Int).SetString(withDrawAmount, 10)
+		batch = append(batch, withdraw)
+		recipients = append(recipients, common.HexToAddress(users[withdraw.UserId].Address))
+		values = append(values, tmpWithdrawAmount)
+	}
+	if 0 >= len(batch) {
+		return nil
+	}
+
+	// gas、授权和余额在事务外从节点取好，事务内只本地签名
+	var call *withdrawCall
+	err = u.pool.Once(ctx, func(ctx context.Context, client rpcpool.Backend) error {
+		var errTwo error
+		call, errTwo = toTokenBatch(ctx, client, u.signer, u.gas, u.cc.GetDisperseContract(), tokenAddress, recipients, values)
+		return errTwo
+	})
+	if nil != err {
+		return err
+	}
+
+	wt, err = u.uuc.WithdrawBatchSign(ctx, batch, func(nonce uint64) (*biz.WithdrawTx, error) {
+		return call.sign(ctx, u.pool.ChainId(), u.signer, nonce)
+	})
+	if nil != err {
+		return err
+	}
+
+	// 交易已落库，广播结果交给确认任务
+	err = u.uuc.SendWithdrawTx(ctx, wt)
+	if nil != err {
+		u.log.Warnf("withdraw batch send failed, left to confirm task: %v, batchId=%d, txHash=%s", err, wt.BatchId, wt.TxHash)
+	}
+
+	return nil
+}
+
 func (u *UserService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginReply, error) {
 	return u.uuc.AdminLogin(ctx, req, u.ca.JwtKey)
 }
@@ -315,6 +415,161 @@ func (u *UserService) AdminRewardList(ctx context.Context, req *pb.AdminRewardLi
 	return u.uuc.AdminRewardList(ctx, req)
 }
 
+func (u *UserService) AdminCallbackEventList(ctx context.Context, req *pb.AdminCallbackEventListRequest) (*pb.AdminCallbackEventListReply, error) {
+	return u.uuc.AdminCallbackEventList(ctx, req)
+}
+
+func (u *UserService) AdminCardProductList(ctx context.Context, req *pb.AdminCardProductListRequest) (*pb.AdminCardProductListReply, error) {
+	return u.uuc.AdminCardProductList(ctx, req)
+}
+
+func (u *UserService) AdminCardProductEnable(ctx context.Context, req *pb.AdminCardProductEnableRequest) (*pb.AdminCardProductEnableReply, error) {
+	return u.uuc.AdminCardProductEnable(ctx, req)
+}
+
+func (u *UserService) AdminCardProductDefault(ctx context.Context, req *pb.AdminCardProductDefaultRequest) (*pb.AdminCardProductDefaultReply, error) {
+	return u.uuc.AdminCardProductDefault(ctx, req)
+}
+
+func (u *UserService) AdminCardRecharge(ctx context.Context, req *pb.AdminCardRechargeRequest) (*pb.AdminCardRechargeReply, error) {
+	return u.uuc.AdminCardRecharge(ctx, req)
+}
+
+func (u *UserService) AdminCardRechargeList(ctx context.Context, req *pb.AdminCardRechargeListRequest) (*pb.AdminCardRechargeListReply, error) {
+	return u.uuc.AdminCardRechargeList(ctx, req)
+}
+
+func (u *UserService) AdminCardFreeze(ctx context.Context, req *pb.AdminCardFreezeRequest) (*pb.AdminCardFreezeReply, error) {
+	return u.uuc.AdminCardFreeze(ctx, req)
+}
+
+func (u *UserService) AdminCardCancel(ctx context.Context, req *pb.AdminCardCancelRequest) (*pb.AdminCardCancelReply, error) {
+	return u.uuc.AdminCardCancel(ctx, req)
+}
+
+func (u *UserService) AdminCardLimit(ctx context.Context, req *pb.AdminCardLimitRequest) (*pb.AdminCardLimitReply, error) {
+	return u.uuc.AdminCardLimit(ctx, req)
+}
+
+func (u *UserService) CardTransactionSyncHandle(ctx context.Context, req *pb.CardTransactionSyncHandleRequest) (*pb.CardTransactionSyncHandleReply, error) {
+	err := u.uuc.CardTransactionSyncHandle(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	return nil, nil
+}
+
+func (u *UserService) AdminCardTransactionList(ctx context.Context, req *pb.AdminCardTransactionListRequest) (*pb.AdminCardTransactionListReply, error) {
+	return u.uuc.AdminCardTransactionList(ctx, req)
+}
+
+func (u *UserService) CardBalanceHandle(ctx context.Context, req *pb.CardBalanceHandleRequest) (*pb.CardBalanceHandleReply, error) {
+	err := u.uuc.CardBalanceHandle(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	return nil, nil
+}
+
+func (u *UserService) AdminCardBalanceDriftList(ctx context.Context, req *pb.AdminCardBalanceDriftListRequest) (*pb.AdminCardBalanceDriftListReply, error) {
+	return u.uuc.AdminCardBalanceDriftList(ctx, req)
+}
+
+func (u *UserService) AdminCardTwoOrderList(ctx context.Context, req *pb.AdminCardTwoOrderListRequest) (*pb.AdminCardTwoOrderListReply, error) {
+	return u.uuc.AdminCardTwoOrderList(ctx, req)
+}
+
+func (u *UserService) AdminCardTwoOrderStatus(ctx context.Context, req *pb.AdminCardTwoOrderStatusRequest) (*pb.AdminCardTwoOrderStatusReply, error) {
+	return u.uuc.AdminCardTwoOrderStatus(ctx, req)
+}
+
+func (u *UserService) AdminCardTwoOrderAddress(ctx context.Context, req *pb.AdminCardTwoOrderAddressRequest) (*pb.AdminCardTwoOrderAddressReply, error) {
+	return u.uuc.AdminCardTwoOrderAddress(ctx, req)
+}
+
+func (u *UserService) AdminUserCardList(ctx context.Context, req *pb.AdminUserCardListRequest) (*pb.AdminUserCardListReply, error) {
+	return u.uuc.AdminUserCardList(ctx, req)
+}
+
+func (u *UserService) AdminUserCardApply(ctx context.Context, req *pb.AdminUserCardApplyRequest) (*pb.AdminUserCardApplyReply, error) {
+	return u.uuc.AdminUserCardApply(ctx, req)
+}
+
+func (u *UserService) AdminCardProductUserCardMax(ctx context.Context, req *pb.AdminCardProductUserCardMaxRequest) (*pb.AdminCardProductUserCardMaxReply, error) {
+	return u.uuc.AdminCardProductUserCardMax(ctx, req)
+}
+
+func (u *UserService) AdminUserCardHistoryList(ctx context.Context, req *pb.AdminUserCardHistoryListRequest) (*pb.AdminUserCardHistoryListReply, error) {
+	return u.uuc.AdminUserCardHistoryList(ctx, req)
+}
+
+func (u *UserService) SensitiveEncryptHandle(ctx context.Context, req *pb.SensitiveEncryptHandleRequest) (*pb.SensitiveEncryptHandleReply, error) {
+	err := u.uuc.SensitiveEncryptHandle(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	return nil, nil
+}
+
+func (u *UserService) WithdrawConfirmHandle(ctx context.Context, req *pb.WithdrawConfirmHandleRequest) (*pb.WithdrawConfirmHandleReply, error) {
+	err := u.uuc.WithdrawConfirmHandle(ctx, time.Now().UTC().Add(50*time.Second))
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	return nil, nil
+}
+
+func (u *UserService) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
+	return u.uuc.AdminWithdrawList(ctx, req)
+}
+
+func (u *UserService) AdminWithdrawAudit(ctx context.Context, req *pb.AdminWithdrawAuditRequest) (*pb.AdminWithdrawAuditReply, error) {
+	adminId := adminIdFromContext(ctx)
+	if 0 >= adminId {
+		return nil, errors.New(403, "AUDIT_DENIED", "无权限审核")
+	}
+
+	return u.uuc.AdminWithdrawAudit(ctx, req, adminId)
+}
+
+func (u *UserService) AdminWithdrawReplace(ctx context.Context, req *pb.AdminWithdrawReplaceRequest) (*pb.AdminWithdrawReplaceReply, error) {
+	return u.uuc.AdminWithdrawReplace(ctx, req)
+}
+
+// adminIdFromContext 后台 token 里的管理员id
+func adminIdFromContext(ctx context.Context) uint64 {
+	if claims, ok := jwt.FromContext(ctx); ok {
+		if c, okTwo := claims.(jwt2.MapClaims); okTwo && "admin" == c["UserType"] {
+			if id, okThree := c["UserId"].(float64); okThree {
+				return uint64(id)
+			}
+		}
+	}
+
+	return 0
+}
+
+func (u *UserService) AdminCardReveal(ctx context.Context, req *pb.AdminCardRevealRequest) (*pb.AdminCardRevealReply, error) {
+	adminId := adminIdFromContext(ctx)
+	if 0 >= adminId {
+		return nil, errors.New(403, "REVEAL_DENIED", "无权限查看卡号")
+	}
+
+	return u.uuc.AdminCardReveal(ctx, req, adminId)
+}
+
+func (u *UserService) AdminCardRevealLogList(ctx context.Context, req *pb.AdminCardRevealLogListRequest) (*pb.AdminCardRevealLogListReply, error) {
+	return u.uuc.AdminCardRevealLogList(ctx, req)
+}
+
+func (u *UserService) AdminIssuerJournalList(ctx context.Context, req *pb.AdminIssuerJournalListRequest) (*pb.AdminIssuerJournalListReply, error) {
+	return u.uuc.AdminIssuerJournalList(ctx, req)
+}
+
 func (u *UserService) AdminUserList(ctx context.Context, req *pb.AdminUserListRequest) (*pb.AdminUserListReply, error) {
 	return u.uuc.AdminUserList(ctx, req)
 }
@@ -352,238 +607,153 @@ func (u *UserService) CallBack(w http.ResponseWriter, r *http.Request) {
 	// 从 http.Request 获取 context.Context
 	ctx := r.Context()
 
-	//body, _ := io.ReadAll(r.Body)
-	//fmt.Println("Raw Body:", string(body))
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Read body failed: "+err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	var req CallbackRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err = json.Unmarshal(body, &req); err != nil {
 		http.Error(w, "Invalid JSON: "+err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	// 只要 Version 和 EventType，例如
-	//fmt.Println("Version:", req.Version)
-	//fmt.Println("EventType:", req.EventType)
-	//fmt.Println("auth:", r.Header.Get("Authorization"))
-	//for k, v := range r.Header {
-	//	fmt.Println("Header:", k, v)
-	//}
-
-	eventType := req.EventType
-
-	switch {
-	case strings.HasPrefix(eventType, "vcc.card.recharge.fai"):
-		var rechargeData *biz.RechargeData
-		if err := json.Unmarshal(req.Data, &rechargeData); err != nil {
-			fmt.Println("Parse recharge data failed:", err, string(req.Data))
-		}
-		_ = u.uuc.CallBackHandleThree(ctx, rechargeData)
-
-	case strings.HasPrefix(eventType, "vcc.cardholder.create.fail"):
-		var cardholderData *biz.CardUserHandle
-		if err := json.Unmarshal(req.Data, &cardholderData); err != nil {
-			fmt.Println("Parse cardholder data failed:", err, string(req.Data))
-		}
-		_ = u.uuc.CallBackHandleOne(ctx, cardholderData)
-
-	case strings.HasPrefix(eventType, "vcc.card.create.fai"):
-		var createData *biz.CardCreateData
-		if err := json.Unmarshal(req.Data, &createData); err != nil {
-			fmt.Println("Parse create data failed:", err, string(req.Data))
-		}
-		_ = u.uuc.CallBackHandleTwo(ctx, createData)
+	// 没有 eventId 的用报文摘要去重
+	eventId := req.EventId
+	if "" == eventId {
+		eventId = fmt.Sprintf("md5:%x", md5.Sum(body))
+	}
 
-	default:
-		fmt.Println("Unhandled event type:", eventType, string(req.Data))
+	// 落库成功才回 200，处理交给 CallbackEventHandle
+	err = u.uuc.CallBackEventSave(ctx, &biz.CardCallbackEvent{
+		EventId:   eventId,
+		EventType: req.EventType,
+		EventName: req.EventName,
+		SourceId:  req.SourceId,
+		Payload:   string(body),
+	})
+	if nil != err {
+		fmt.Println("回调保存失败:", err, req.EventType, eventId)
+		http.Error(w, "Save event failed", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.Write([]byte(`{"status":"ok"}`))
 }
 
-func getUserLength(address string) (int64, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
-
-	var balInt int64
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		bals, err := instance.GetUserLength(&bind.CallOpts{})
-		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
-		}
+// withdrawCall 热钱包要发的合约调用，gas price 和 gas limit 在分配 nonce 之前从节点取好，
+// 持有 nonce 行锁时只做本地签名，不访问节点
+type withdrawCall struct {
+	to       common.Address
+	data     []byte
+	gasPrice *big.Int
+	gasLimit uint64
+}
 
-		balInt = bals.Int64()
-		break
+// newWithdrawCall gas price 取节点建议价，gas limit 按热钱包估算，超过上限时不签，count 为打款笔数
+func newWithdrawCall(ctx context.Context, client rpcpool.Backend, s signer.Signer, policy *gas.Policy, to common.Address, data []byte, count int) (*withdrawCall, error) {
+	suggested, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := policy.Price(suggested)
+	if err != nil {
+		return nil, err
 	}
 
-	return balInt, nil
-}
+	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{From: s.Address(), To: &to, GasPrice: gasPrice, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	if err = policy.CheckLimit(gasLimit, count); err != nil {
+		return nil, err
+	}
 
-type userDeposit struct {
-	Address string
-	Amount  int64
+	return &withdrawCall{to: to, data: data, gasPrice: gasPrice, gasLimit: gasLimit}, nil
 }
 
-func getUserInfo(start int64, end int64, address string) ([]*userDeposit, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
-
-	var (
-		bals  []common.Address
-		bals2 []*big.Int
-	)
-	users := make([]*userDeposit, 0)
-
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		bals, err = instance.GetUsersByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
-		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
-		}
-
-		break
+// sign 用分配好的 nonce 签名，只签名不广播，私钥不经过这里
+func (c *withdrawCall) sign(ctx context.Context, chainId uint64, s signer.Signer, nonce uint64) (*biz.WithdrawTx, error) {
+	tx, err := s.SignTx(ctx, types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: c.gasPrice,
+		Gas:      c.gasLimit,
+		To:       &c.to,
+		Data:     c.data,
+	}), new(big.Int).SetUint64(chainId))
+	if err != nil {
+		return nil, err
 	}
 
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		bals2, err = instance.GetUsersAmountByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
-		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
-		}
-
-		break
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
 	}
 
-	if len(bals) != len(bals2) {
-		fmt.Println("数量不一致，错误")
-		return users, nil
-	}
+	return &biz.WithdrawTx{
+		TxHash:   tx.Hash().Hex(),
+		GasPrice: tx.GasPrice().String(),
+		RawTx:    hexutil.Encode(rawTx),
+	}, nil
+}
 
-	for k, v := range bals {
-		users = append(users, &userDeposit{
-			Address: v.String(),
-			Amount:  bals2[k].Int64(),
-		})
+// toToken 热钱包转出 usdt 的合约调用
+func toToken(ctx context.Context, client rpcpool.Backend, s signer.Signer, policy *gas.Policy, toAccount string, withdrawAmount string, withdrawTokenAddress string) (*withdrawCall, error) {
+	parsed, err := DfilMetaData.GetAbi()
+	if err != nil {
+		return nil, err
 	}
 
-	return users, nil
-}
-
-func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string, url1 string) (string, error) {
-	client, err := ethclient.Dial(url1)
-	//client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
+	tmpWithdrawAmount, _ := new(big.Int).SetString(withdrawAmount, 10)
+	data, err := parsed.Pack("transfer", common.HexToAddress(toAccount), tmpWithdrawAmount)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
+	return newWithdrawCall(ctx, client, s, policy, common.HexToAddress(withdrawTokenAddress), data, 1)
+}
+
+// toTokenBatch 一笔 disperseToken 交易打给多个地址，合约内任何一笔转账失败整笔回滚
+// 热钱包需提前 approve 给 disperse 合约，授权或余额不足时不签
+func toTokenBatch(ctx context.Context, client rpcpool.Backend, s signer.Signer, policy *gas.Policy, disperseAddress string, withdrawTokenAddress string, recipients []common.Address, values []*big.Int) (*withdrawCall, error) {
 	tokenAddress := common.HexToAddress(withdrawTokenAddress)
-	instance, err := NewDfil(tokenAddress, client)
+	token, err := NewDfil(tokenAddress, client)
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
 
-	var authUser *bind.TransactOpts
+	total := new(big.Int)
+	for _, v := range values {
+		total.Add(total, v)
+	}
 
-	var privateKey *ecdsa.PrivateKey
-	privateKey, err = crypto.HexToECDSA(userPrivateKey)
+	callOpts := &bind.CallOpts{Context: ctx}
+	allowance, err := token.Allowance(callOpts, s.Address(), common.HexToAddress(disperseAddress))
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
+	}
+	if allowance.Cmp(total) < 0 {
+		return nil, fmt.Errorf("hot wallet allowance %s less than batch total %s", allowance.String(), total.String())
 	}
 
-	//gasPrice, err := client.SuggestGasPrice(context.Background())
-	//if err != nil {
-	//	fmt.Println(err)
-	//	return "", err
-	//}
-
-	authUser, err = bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetInt64(56))
+	balance, err := token.BalanceOf(callOpts, s.Address())
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
+	}
+	if balance.Cmp(total) < 0 {
+		return nil, fmt.Errorf("hot wallet balance %s less than batch total %s", balance.String(), total.String())
 	}
 
-	tmpWithdrawAmount, _ := new(big.Int).SetString(withdrawAmount, 10)
-	_, err = instance.Transfer(&bind.TransactOpts{
-		From:     authUser.From,
-		Signer:   authUser.Signer,
-		GasLimit: 0,
-	}, common.HexToAddress(toAccount), tmpWithdrawAmount)
+	parsed, err := DisperseMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("disperseToken", tokenAddress, recipients, values)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return "", nil
+	return newWithdrawCall(ctx, client, s, policy, common.HexToAddress(disperseAddress), data, len(recipients))
 }